@@ -2,18 +2,40 @@ package common
 
 import (
 	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
 	"github.com/nknorg/nkn/transaction"
 	"github.com/nknorg/nkn/vault"
 )
 
-func MakeTransferTransaction(wallet vault.Wallet, receipt Uint160, nonce uint64, value, fee Fixed64) (*transaction.Transaction, error) {
+func MakeTransferTransaction(wallet vault.Wallet, receipt Uint160, assetID Uint256, nonce uint64, value, fee Fixed64) (*transaction.Transaction, error) {
 	account, err := wallet.GetDefaultAccount()
 	if err != nil {
 		return nil, err
 	}
 
 	// construct transaction
-	txn, err := transaction.NewTransferAssetTransaction(account.ProgramHash, receipt, nonce, value, fee)
+	txn, err := transaction.NewTransferAssetTransaction(account.ProgramHash, receipt, assetID, nonce, value, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	// sign transaction contract
+	err = wallet.Sign(txn)
+	if err != nil {
+		return nil, err
+	}
+
+	return txn, nil
+}
+
+func MakeBatchTransferTransaction(wallet vault.Wallet, outputs []*pb.TransferOutput, nonce uint64, fee Fixed64) (*transaction.Transaction, error) {
+	account, err := wallet.GetDefaultAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// construct transaction
+	txn, err := transaction.NewBatchTransferAssetTransaction(account.ProgramHash, outputs, nonce, fee)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +88,26 @@ func MakeRegisterNameTransaction(wallet vault.Wallet, name string, nonce uint64,
 	return txn, nil
 }
 
+func MakeTransferNameTransaction(wallet vault.Wallet, name string, recipient []byte, nonce uint64, fee Fixed64) (*transaction.Transaction, error) {
+	account, err := wallet.GetDefaultAccount()
+	if err != nil {
+		return nil, err
+	}
+	registrant := account.PubKey().EncodePoint()
+	txn, err := transaction.NewTransferNameTransaction(registrant, recipient, name, nonce, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	// sign transaction contract
+	err = wallet.Sign(txn)
+	if err != nil {
+		return nil, err
+	}
+
+	return txn, nil
+}
+
 func MakeDeleteNameTransaction(wallet vault.Wallet, name string, nonce uint64, fee Fixed64) (*transaction.Transaction, error) {
 	account, err := wallet.GetDefaultAccount()
 	if err != nil {
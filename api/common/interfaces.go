@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/nknorg/nkn/block"
 	"github.com/nknorg/nkn/chain"
@@ -183,6 +186,77 @@ func getBlockTxsByHeight(s Serverer, params map[string]interface{}) map[string]i
 	return respPacking(SUCCESS, txs)
 }
 
+const (
+	defaultTxsByAddrLimit = 20
+	maxTxsByAddrLimit     = 100
+)
+
+// getTxsByAddr gets transactions related to an address, newest first. Result
+// is not complete if older transactions may be in blocks before indexStart.
+// params: {"address":<address>, "offset":<offset>, "limit":<limit>}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func getTxsByAddr(s Serverer, params map[string]interface{}) map[string]interface{} {
+	if len(params) < 1 {
+		return respPacking(INVALID_PARAMS, "length of params is less than 1")
+	}
+
+	addr, ok := params["address"].(string)
+	if !ok {
+		return respPacking(INVALID_PARAMS, "address should be a string")
+	}
+
+	pg, err := common.ToScriptHash(addr)
+	if err != nil {
+		return respPacking(INVALID_PARAMS, err.Error())
+	}
+
+	offset := 0
+	if v, ok := params["offset"]; ok {
+		f, ok := v.(float64)
+		if !ok || f < 0 {
+			return respPacking(INVALID_PARAMS, "offset should be a non-negative number")
+		}
+		offset = int(f)
+	}
+
+	limit := defaultTxsByAddrLimit
+	if v, ok := params["limit"]; ok {
+		f, ok := v.(float64)
+		if !ok || f < 1 || f > maxTxsByAddrLimit {
+			return respPacking(INVALID_PARAMS, fmt.Sprintf("limit should be a number between 1 and %d", maxTxsByAddrLimit))
+		}
+		limit = int(f)
+	}
+
+	txns, err := chain.DefaultLedger.Store.GetTxnsByAddress(pg, offset, limit)
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	txs := make([]interface{}, 0, len(txns))
+	for _, txn := range txns {
+		txs = append(txs, map[string]interface{}{
+			"hash":   txn.Hash.ToHexString(),
+			"height": txn.Height,
+			"index":  txn.Index,
+		})
+	}
+
+	// txns in blocks before the address index starts are not included, so a
+	// short page is only complete if the index starts from genesis block
+	indexStart := chain.DefaultLedger.Store.GetAddressIndexStart()
+
+	ret := map[string]interface{}{
+		"offset":     offset,
+		"limit":      limit,
+		"txs":        txs,
+		"indexStart": indexStart,
+		"complete":   len(txns) == limit || indexStart == 0,
+	}
+
+	return respPacking(SUCCESS, ret)
+}
+
 // getConnectionCount gets the the number of Connections
 // params: {}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
@@ -195,8 +269,9 @@ func getConnectionCount(s Serverer, params map[string]interface{}) map[string]in
 	return respPacking(SUCCESS, localNode.GetConnectionCnt())
 }
 
-// getRawMemPool gets the transactions in txpool
-// params: {}
+// getRawMemPool gets the transactions in txpool, the pool size and limits, or
+// the txns recently evicted from it
+// params: {"action":<addresslist|txnlist|info|evicted>, "address":<address, txnlist only>}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
 func getRawMemPool(s Serverer, params map[string]interface{}) map[string]interface{} {
 	if len(params) < 1 {
@@ -258,9 +333,40 @@ func getRawMemPool(s Serverer, params map[string]interface{}) map[string]interfa
 			txs = append(txs, x)
 		}
 
+		return respPacking(SUCCESS, txs)
+	case "info":
+		info := txpool.GetPoolInfo()
+		return respPacking(SUCCESS, map[string]interface{}{
+			"txcount":       info.TxnCount,
+			"size":          info.TxnSize,
+			"maxtxns":       info.MaxTxns,
+			"maxsize":       info.MaxSize,
+			"ttl":           int64(info.TxnTTL / time.Second),
+			"minfeebump":    info.MinFeeBump,
+			"futuretxcount": info.FutureTxnCount,
+			"maxfuturetxns": info.MaxFutureTxns,
+		})
+	case "evicted":
+		txs := []interface{}{}
+		for _, e := range txpool.GetEvictedTransactions() {
+			addr, err := e.Sender.ToAddress()
+			if err != nil {
+				return respPacking(INTERNAL_ERROR, err.Error())
+			}
+			txs = append(txs, map[string]interface{}{
+				"hash":    e.Hash.ToHexString(),
+				"address": addr,
+				"nonce":   e.Nonce,
+				"fee":     e.Fee,
+				"size":    e.Size,
+				"reason":  e.Reason,
+				"time":    e.Time.Unix(),
+			})
+		}
+
 		return respPacking(SUCCESS, txs)
 	default:
-		return respPacking(INVALID_PARAMS, "action should be addresslist or txnlist")
+		return respPacking(INVALID_PARAMS, "action should be addresslist, txnlist, info or evicted")
 	}
 
 }
@@ -304,6 +410,222 @@ func getTransaction(s Serverer, params map[string]interface{}) map[string]interf
 	return respPacking(SUCCESS, tran)
 }
 
+// estimateFee estimates the fee a transaction should pay to be included within
+// target blocks, from the fees in recent blocks and in txpool
+// params: {"target":<number of blocks, default 1>, "size":<txn size in bytes, optional>}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func estimateFee(s Serverer, params map[string]interface{}) map[string]interface{} {
+	target := uint32(1)
+	if v, ok := params["target"]; ok {
+		t, ok := v.(float64)
+		if !ok || t < 1 {
+			return respPacking(INVALID_PARAMS, "target should be a positive integer")
+		}
+		target = uint32(t)
+	}
+
+	size := 0
+	if v, ok := params["size"]; ok {
+		n, ok := v.(float64)
+		if !ok || n < 1 {
+			return respPacking(INVALID_PARAMS, "size should be a positive integer")
+		}
+		size = int(n)
+	}
+
+	localNode, err := s.GetNetNode()
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	estimate, err := localNode.GetTxnPool().EstimateFee(target, size)
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	return respPacking(SUCCESS, map[string]interface{}{
+		"fee":        estimate.Fee.String(),
+		"blockFee":   estimate.BlockFee.String(),
+		"backlogFee": estimate.BacklogFee.String(),
+		"size":       estimate.Size,
+		"target":     estimate.Target,
+		"blocks":     estimate.Blocks,
+		"backlog":    estimate.Backlog,
+	})
+}
+
+// simulateTransaction checks whether a signed or unsigned transaction would be
+// accepted and what it would change, without adding it to txpool
+// params: {"tx":<transaction hex>}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func simulateTransaction(s Serverer, params map[string]interface{}) map[string]interface{} {
+	str, ok := params["tx"].(string)
+	if !ok {
+		return respPacking(INVALID_PARAMS, "tx should be a string")
+	}
+
+	buf, err := common.HexStringToBytes(str)
+	if err != nil {
+		return respPacking(INVALID_PARAMS, err.Error())
+	}
+	var txn transaction.Transaction
+	if err := txn.Unmarshal(buf); err != nil {
+		return respPacking(INVALID_TRANSACTION, err.Error())
+	}
+	if txn.UnsignedTx == nil || txn.UnsignedTx.Payload == nil {
+		return respPacking(INVALID_TRANSACTION, "transaction has no payload")
+	}
+
+	localNode, err := s.GetNetNode()
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	sim := localNode.GetTxnPool().SimulateTransaction(&txn)
+
+	senderAddr, err := sim.Sender.ToAddress()
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	balances := make([]interface{}, 0, len(sim.BalanceChanges))
+	for _, c := range sim.BalanceChanges {
+		addr, err := c.Address.ToAddress()
+		if err != nil {
+			return respPacking(INTERNAL_ERROR, err.Error())
+		}
+		balances = append(balances, map[string]interface{}{
+			"address": addr,
+			"assetId": c.AssetID.ToHexString(),
+			"amount":  c.Amount.String(),
+		})
+	}
+
+	names := make([]interface{}, 0, len(sim.NameChanges))
+	for _, c := range sim.NameChanges {
+		info := map[string]interface{}{
+			"action":     c.Action,
+			"name":       c.Name,
+			"registrant": common.BytesToHexString(c.Registrant),
+		}
+		if c.Recipient != nil {
+			info["recipient"] = common.BytesToHexString(c.Recipient)
+		}
+		if c.Expiration > 0 {
+			info["expiresAt"] = c.Expiration
+		}
+		names = append(names, info)
+	}
+
+	subscriptions := make([]interface{}, 0, len(sim.SubscriptionChanges))
+	for _, c := range sim.SubscriptionChanges {
+		subscriptions = append(subscriptions, map[string]interface{}{
+			"topic":      c.Topic,
+			"bucket":     c.Bucket,
+			"subscriber": address.MakeAddressString(c.Subscriber, c.Identifier),
+			"meta":       c.Meta,
+			"expiresAt":  c.Expiration,
+		})
+	}
+
+	result := map[string]interface{}{
+		"hash":                sim.Hash.ToHexString(),
+		"sender":              senderAddr,
+		"signed":              sim.Signed,
+		"valid":               sim.Err == nil,
+		"nonce":               sim.Nonce,
+		"newNonce":            sim.NewNonce,
+		"balanceChanges":      balances,
+		"nameChanges":         names,
+		"subscriptionChanges": subscriptions,
+	}
+	if sim.Err != nil {
+		result["error"] = strings.TrimSpace(sim.Err.Error())
+	}
+
+	return respPacking(SUCCESS, result)
+}
+
+// getTxProof gets merkle branch proving a transaction is included in its block
+// params: {"hash":<transaction hash>}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func getTxProof(s Serverer, params map[string]interface{}) map[string]interface{} {
+	if len(params) < 1 {
+		return respPacking(INVALID_PARAMS, "length of params is less than 1")
+	}
+
+	str, ok := params["hash"].(string)
+	if !ok {
+		return respPacking(INVALID_PARAMS, "hash should be a string")
+	}
+
+	hex, err := common.HexStringToBytes(str)
+	if err != nil {
+		return respPacking(INVALID_PARAMS, err.Error())
+	}
+	var hash common.Uint256
+	err = hash.Deserialize(bytes.NewReader(hex))
+	if err != nil {
+		return respPacking(INVALID_PARAMS, err.Error())
+	}
+
+	height, err := chain.DefaultLedger.Store.GetTransactionHeight(hash)
+	if err != nil {
+		return respPacking(UNKNOWN_TRANSACTION, err.Error())
+	}
+
+	block, err := chain.DefaultLedger.Store.GetBlockByHeight(height)
+	if err != nil {
+		return respPacking(UNKNOWN_BLOCK, err.Error())
+	}
+
+	index := -1
+	txnsHash := make([]common.Uint256, 0, len(block.Transactions))
+	for i, txn := range block.Transactions {
+		txnHash := txn.Hash()
+		if txnHash == hash {
+			index = i
+		}
+		txnsHash = append(txnsHash, txnHash)
+	}
+	if index < 0 {
+		return respPacking(UNKNOWN_TRANSACTION, "transaction not found in block")
+	}
+
+	tree, err := crypto.NewMerkleTree(txnsHash)
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	branch, err := tree.GetBranch(index)
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	hashes := make([]string, 0, len(branch))
+	for _, h := range branch {
+		hashes = append(hashes, h.ToHexString())
+	}
+
+	var header interface{}
+	info, err := block.Header.GetInfo()
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	json.Unmarshal(info, &header)
+
+	ret := map[string]interface{}{
+		"height":  height,
+		"header":  header,
+		"index":   index,
+		"txCount": len(txnsHash),
+		"branch":  hashes,
+	}
+
+	return respPacking(SUCCESS, ret)
+}
+
 // sendRawTransaction  sends raw transaction to the block chain
 // params: {"tx":<transaction>}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
@@ -363,6 +685,64 @@ func getNodeState(s Serverer, params map[string]interface{}) map[string]interfac
 	return respPacking(SUCCESS, localNode)
 }
 
+// getConsensusTrace gets the consensus record of a recent height, or the
+// summaries of all recorded heights if height is omitted
+// params: {"height":<height, optional>}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func getConsensusTrace(s Serverer, params map[string]interface{}) map[string]interface{} {
+	localNode, err := s.GetNetNode()
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	t := localNode.GetConsensusTrace()
+	if t == nil {
+		return respPacking(INTERNAL_ERROR, "consensus has not started")
+	}
+
+	if _, ok := params["height"]; !ok {
+		return respPacking(SUCCESS, t.Summaries())
+	}
+
+	height, ok := params["height"].(float64)
+	if !ok || height < 0 {
+		return respPacking(INVALID_PARAMS, "height should be a non-negative number")
+	}
+
+	record, ok := t.Get(uint32(height))
+	if !ok {
+		return respPacking(UNKNOWN_BLOCK, fmt.Sprintf("no consensus record of height %d", uint32(height)))
+	}
+
+	return respPacking(SUCCESS, record)
+}
+
+// getEquivocationEvidence gets the conflicting signed votes and proposals the
+// node has received, optionally only those of a node
+// params: {"nodeId":<node id, optional>}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func getEquivocationEvidence(s Serverer, params map[string]interface{}) map[string]interface{} {
+	localNode, err := s.GetNetNode()
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	pool := localNode.GetEvidencePool()
+	if pool == nil {
+		return respPacking(INTERNAL_ERROR, "consensus has not started")
+	}
+
+	var nodeID string
+	if _, ok := params["nodeId"]; ok {
+		nodeID, ok = params["nodeId"].(string)
+		if !ok {
+			return respPacking(INVALID_PARAMS, "nodeId should be a string")
+		}
+	}
+
+	return respPacking(SUCCESS, pool.Get(nodeID))
+}
+
 // setDebugInfo sets log level
 // params: {"level":<log leverl>}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
@@ -595,8 +975,9 @@ func VerifyAndSendTx(localNode *node.LocalNode, txn *transaction.Transaction) (E
 	return ErrNoError, nil
 }
 
-// getAddressByName get address by name
-// params: {"name":<name>}
+// getAddressByName get address by name, and its expiration height if
+// withExpiry is true
+// params: {"name":<name>, "withExpiry":<bool>}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
 func getAddressByName(s Serverer, params map[string]interface{}) map[string]interface{} {
 	if len(params) < 1 {
@@ -608,30 +989,57 @@ func getAddressByName(s Serverer, params map[string]interface{}) map[string]inte
 		return respPacking(INVALID_PARAMS, "name should be a string")
 	}
 
-	publicKey, err := chain.DefaultLedger.Store.GetRegistrant(name)
+	withExpiry := false
+	if value, ok := params["withExpiry"]; ok {
+		withExpiry, ok = value.(bool)
+		if !ok {
+			return respPacking(INVALID_PARAMS, "withExpiry should be a bool")
+		}
+	}
+
+	address, err := getNameAddress(name)
 	if err != nil {
 		return respPacking(INTERNAL_ERROR, err.Error())
 	}
-	if publicKey == nil {
-		return respPacking(INTERNAL_ERROR, "no such name registered")
+
+	if !withExpiry {
+		return respPacking(SUCCESS, address)
 	}
 
-	pubKey, err := crypto.NewPubKeyFromBytes(publicKey)
+	expiresAt, err := chain.DefaultLedger.Store.GetNameExpiresAt(name)
 	if err != nil {
 		return respPacking(INTERNAL_ERROR, err.Error())
 	}
 
-	programHash, err := program.CreateProgramHash(pubKey)
+	ret := map[string]interface{}{
+		"address":   address,
+		"expiresAt": expiresAt,
+	}
+
+	return respPacking(SUCCESS, ret)
+}
+
+// getNameAddress returns the address of the registrant of a name.
+func getNameAddress(name string) (string, error) {
+	publicKey, err := chain.DefaultLedger.Store.GetRegistrant(name)
 	if err != nil {
-		return respPacking(INTERNAL_ERROR, err.Error())
+		return "", err
+	}
+	if publicKey == nil {
+		return "", errors.New("no such name registered")
 	}
 
-	address, err := programHash.ToAddress()
+	pubKey, err := crypto.NewPubKeyFromBytes(publicKey)
 	if err != nil {
-		return respPacking(INTERNAL_ERROR, err.Error())
+		return "", err
 	}
 
-	return respPacking(SUCCESS, address)
+	programHash, err := program.CreateProgramHash(pubKey)
+	if err != nil {
+		return "", err
+	}
+
+	return programHash.ToAddress()
 }
 
 // getSubscribers get subscribers by topic
@@ -734,6 +1142,116 @@ func getTopicBucketsCount(s Serverer, params map[string]interface{}) map[string]
 	return respPacking(SUCCESS, count)
 }
 
+func stateProofResponse(sp *chain.StateProof) map[string]interface{} {
+	proof := make([]string, 0, len(sp.Proof))
+	for _, node := range sp.Proof {
+		proof = append(proof, common.BytesToHexString(node))
+	}
+
+	blockHash := sp.Header.Hash()
+	ret := map[string]interface{}{
+		"height":    sp.Header.UnsignedHeader.Height,
+		"blockHash": blockHash.ToHexString(),
+		"stateRoot": common.BytesToHexString(sp.Header.UnsignedHeader.StateRoot),
+		"key":       common.BytesToHexString(sp.Key),
+		"value":     common.BytesToHexString(sp.Value),
+		"proof":     proof,
+	}
+
+	return respPacking(SUCCESS, ret)
+}
+
+// getBalanceProof gets merkle proof of account state by address
+// params: {"address":<address>}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func getBalanceProof(s Serverer, params map[string]interface{}) map[string]interface{} {
+	if len(params) < 1 {
+		return respPacking(INVALID_PARAMS, "length of params is less than 1")
+	}
+
+	addr, ok := params["address"].(string)
+	if !ok {
+		return respPacking(INVALID_PARAMS, "address should be a string")
+	}
+
+	pg, err := common.ToScriptHash(addr)
+	if err != nil {
+		return respPacking(INVALID_PARAMS, err.Error())
+	}
+
+	sp, err := chain.DefaultLedger.Store.GetAccountProof(pg)
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	return stateProofResponse(sp)
+}
+
+// getNameProof gets merkle proof of name registration by name
+// params: {"name":<name>}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func getNameProof(s Serverer, params map[string]interface{}) map[string]interface{} {
+	if len(params) < 1 {
+		return respPacking(INVALID_PARAMS, "length of params is less than 1")
+	}
+
+	name, ok := params["name"].(string)
+	if !ok {
+		return respPacking(INVALID_PARAMS, "name should be a string")
+	}
+
+	sp, err := chain.DefaultLedger.Store.GetNameProof(name)
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	return stateProofResponse(sp)
+}
+
+// getSubscriptionProof gets merkle proof of a subscription
+// params: {"topic":<topic>, "bucket":<bucket>, "subscriber":<subscriber>, "identifier":<identifier>}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func getSubscriptionProof(s Serverer, params map[string]interface{}) map[string]interface{} {
+	if len(params) < 3 {
+		return respPacking(INVALID_PARAMS, "length of params is less than 3")
+	}
+
+	topic, ok := params["topic"].(string)
+	if !ok {
+		return respPacking(INVALID_PARAMS, "topic should be a string")
+	}
+
+	bucket, ok := params["bucket"].(float64)
+	if !ok {
+		return respPacking(INVALID_PARAMS, "bucket should be a number")
+	}
+
+	str, ok := params["subscriber"].(string)
+	if !ok {
+		return respPacking(INVALID_PARAMS, "subscriber should be a string")
+	}
+
+	subscriber, err := common.HexStringToBytes(str)
+	if err != nil {
+		return respPacking(INVALID_PARAMS, err.Error())
+	}
+
+	identifier := ""
+	if id, ok := params["identifier"]; ok {
+		identifier, ok = id.(string)
+		if !ok {
+			return respPacking(INVALID_PARAMS, "identifier should be a string")
+		}
+	}
+
+	sp, err := chain.DefaultLedger.Store.GetSubscriptionProof(topic, uint32(bucket), subscriber, identifier)
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	return stateProofResponse(sp)
+}
+
 // getMyExtIP get RPC client's external IP
 // params: {"address":<address>}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
@@ -833,14 +1351,20 @@ var InitialAPIHandlers = map[string]APIHandler{
 	"getblockcount":                {Handler: getBlockCount, AccessCtrl: BIT_JSONRPC},
 	"getlatestblockheight":         {Handler: getLatestBlockHeight, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
 	"getblocktxsbyheight":          {Handler: getBlockTxsByHeight, AccessCtrl: BIT_JSONRPC},
+	"gettxsbyaddr":                 {Handler: getTxsByAddr, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
 	"getconnectioncount":           {Handler: getConnectionCount, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
 	"getrawmempool":                {Handler: getRawMemPool, AccessCtrl: BIT_JSONRPC},
 	"gettransaction":               {Handler: getTransaction, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
+	"gettxproof":                   {Handler: getTxProof, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
+	"estimatefee":                  {Handler: estimateFee, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
 	"sendrawtransaction":           {Handler: sendRawTransaction, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
+	"simulatetransaction":          {Handler: simulateTransaction, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
 	"getwsaddr":                    {Handler: getWsAddr, AccessCtrl: BIT_JSONRPC},
 	"getversion":                   {Handler: getVersion, AccessCtrl: BIT_JSONRPC},
 	"getneighbor":                  {Handler: getNeighbor, AccessCtrl: BIT_JSONRPC},
 	"getnodestate":                 {Handler: getNodeState, AccessCtrl: BIT_JSONRPC},
+	"getconsensustrace":            {Handler: getConsensusTrace, AccessCtrl: BIT_JSONRPC},
+	"getequivocationevidence":      {Handler: getEquivocationEvidence, AccessCtrl: BIT_JSONRPC},
 	"getchordringinfo":             {Handler: getChordRingInfo, AccessCtrl: BIT_JSONRPC},
 	"setdebuginfo":                 {Handler: setDebugInfo},
 	"getbalancebyaddr":             {Handler: getBalanceByAddr, AccessCtrl: BIT_JSONRPC},
@@ -852,6 +1376,9 @@ var InitialAPIHandlers = map[string]APIHandler{
 	"getasset":                     {Handler: getAsset, AccessCtrl: BIT_JSONRPC},
 	"getfirstavailabletopicbucket": {Handler: getFirstAvailableTopicBucket, AccessCtrl: BIT_JSONRPC},
 	"gettopicbucketscount":         {Handler: getTopicBucketsCount, AccessCtrl: BIT_JSONRPC},
+	"getbalanceproof":              {Handler: getBalanceProof, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
+	"getnameproof":                 {Handler: getNameProof, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
+	"getsubscriptionproof":         {Handler: getSubscriptionProof, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
 	"getmyextip":                   {Handler: getMyExtIP, AccessCtrl: BIT_JSONRPC},
 	"findsuccessoraddr":            {Handler: findSuccessorAddr, AccessCtrl: BIT_JSONRPC},
 	"findsuccessoraddrs":           {Handler: findSuccessorAddrs, AccessCtrl: BIT_JSONRPC},
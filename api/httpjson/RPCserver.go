@@ -230,6 +230,9 @@ func (s *RPCServer) Start() {
 
 	rpcServeMux := http.NewServeMux()
 	rpcServeMux.HandleFunc("/", s.Handle)
+	if config.Parameters.EnableMetrics {
+		rpcServeMux.HandleFunc(metricsPath, s.handleMetrics)
+	}
 	httpServer := &http.Server{
 		Handler:      rpcServeMux,
 		ReadTimeout:  config.Parameters.RPCReadTimeout * time.Second,
@@ -66,6 +66,40 @@ func GetRemoteBlkHeight(remote string) (uint32, error) {
 	return ret.Result, nil
 }
 
+// EstimateFee returns the fee estimated by the node at remote for a txn to be
+// included within target blocks.
+func EstimateFee(remote string, target uint32) (Fixed64, error) {
+	return EstimateFeeForSize(remote, target, 0)
+}
+
+// EstimateFeeForSize estimates the fee of a txn of size bytes, or of a
+// transfer sized txn if size is 0.
+func EstimateFeeForSize(remote string, target uint32, size int) (Fixed64, error) {
+	params := map[string]interface{}{"target": target}
+	if size > 0 {
+		params["size"] = size
+	}
+	resp, err := Call(remote, "estimatefee", 0, params)
+	if err != nil {
+		return 0, err
+	}
+
+	var ret struct {
+		Result struct {
+			Fee string `json:"fee"`
+		} `json:"result"`
+		Err map[string]interface{} `json:"error"`
+	}
+	if err := json.Unmarshal(resp, &ret); err != nil {
+		return 0, err
+	}
+	if len(ret.Err) != 0 {
+		return 0, fmt.Errorf("estimatefee error: %v", ret.Err)
+	}
+
+	return StringToFixed64(ret.Result.Fee)
+}
+
 func FindSuccessorAddrs(remote string, key []byte) ([]string, error) {
 	resp, err := Call(remote, "findsuccessoraddrs", 0, map[string]interface{}{
 		"key": hex.EncodeToString(key),
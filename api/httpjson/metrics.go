@@ -0,0 +1,145 @@
+package httpjson
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/nknorg/nkn/api/websocket"
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/chain/db"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/log"
+)
+
+const (
+	metricsPath        = "/metrics"
+	metricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+type metricSample struct {
+	labels string // already formatted, e.g. `db="chain"`
+	value  float64
+}
+
+// metricsWriter writes metrics in the Prometheus text exposition format.
+type metricsWriter struct {
+	bytes.Buffer
+}
+
+func (mw *metricsWriter) write(name, metricType, help string, samples ...metricSample) {
+	fmt.Fprintf(mw, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(mw, "# TYPE %s %s\n", name, metricType)
+	for _, s := range samples {
+		mw.WriteString(name)
+		if s.labels != "" {
+			mw.WriteString("{" + s.labels + "}")
+		}
+		mw.WriteString(" " + strconv.FormatFloat(s.value, 'g', -1, 64) + "\n")
+	}
+}
+
+func (mw *metricsWriter) gauge(name, help string, value float64) {
+	mw.write(name, "gauge", help, metricSample{value: value})
+}
+
+func (mw *metricsWriter) counter(name, help string, value float64) {
+	mw.write(name, "counter", help, metricSample{value: value})
+}
+
+// dirSize returns the total size of the regular files under path.
+func dirSize(path string) (int64, error) {
+	var size int64
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+// handleMetrics serves node metrics for Prometheus scraping.
+func (s *RPCServer) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	mw := &metricsWriter{}
+
+	mw.gauge("nknd_block_height", "Height of the latest block in the local ledger.", float64(chain.DefaultLedger.Store.GetHeight()))
+
+	if t := s.localNode.GetConsensusTrace(); t != nil {
+		summaries := t.Summaries()
+		for i := len(summaries) - 1; i >= 0; i-- {
+			if summaries[i].Result != "" {
+				mw.gauge("nknd_consensus_height", "Height of the latest finished election.", float64(summaries[i].Height))
+				mw.gauge("nknd_consensus_latency_seconds", "Time from the first proposal or vote to the result of the latest finished election.", float64(summaries[i].LatencyMs)/1000)
+				break
+			}
+		}
+	}
+	mw.counter("nknd_proposals_submitted_total", "Block proposals submitted by this node.", float64(s.localNode.GetProposalSubmitted()))
+
+	poolInfo := s.localNode.GetTxnPool().GetPoolInfo()
+	mw.gauge("nknd_txpool_txns", "Txns in the pool ready to be included in a block.", float64(poolInfo.TxnCount))
+	mw.gauge("nknd_txpool_bytes", "Total size of txns in the pool.", float64(poolInfo.TxnSize))
+	mw.gauge("nknd_txpool_future_txns", "Txns queued in the pool waiting for a nonce gap to fill.", float64(poolInfo.FutureTxnCount))
+
+	mw.counter("nknd_relay_messages_total", "Messages relayed by this node.", float64(s.localNode.GetRelayMessageCount()))
+
+	heights, neighborCount := s.localNode.GetNeighborHeights()
+	var maxNeighborHeight uint32
+	for _, height := range heights {
+		if height > maxNeighborHeight {
+			maxNeighborHeight = height
+		}
+	}
+	mw.gauge("nknd_neighbors", "Connected neighbors.", float64(neighborCount))
+	mw.gauge("nknd_neighbor_max_height", "Highest block height reported by neighbors, the target of syncing.", float64(maxNeighborHeight))
+
+	syncState := s.localNode.GetSyncState()
+	states := make([]int, 0, len(pb.SyncState_name))
+	for v := range pb.SyncState_name {
+		states = append(states, int(v))
+	}
+	sort.Ints(states)
+	samples := make([]metricSample, 0, len(states))
+	for _, v := range states {
+		value := 0.0
+		if pb.SyncState(v) == syncState {
+			value = 1
+		}
+		samples = append(samples, metricSample{labels: fmt.Sprintf("state=%q", pb.SyncState_name[int32(v)]), value: value})
+	}
+	mw.write("nknd_sync_state", "gauge", "Current sync state, 1 for the active state.", samples...)
+
+	mw.gauge("nknd_websocket_sessions", "Open websocket sessions.", float64(websocket.GetSessionCount()))
+
+	samples = make([]metricSample, 0, 2)
+	if config.Parameters.ChainDBBackend != db.MemoryBackend {
+		if size, err := dirSize(config.Parameters.ChainDBPath); err == nil {
+			samples = append(samples, metricSample{labels: `db="chain"`, value: float64(size)})
+		} else {
+			log.Warningf("Get chain db size error: %v", err)
+		}
+	}
+	if config.Parameters.TxPoolJournal != "" {
+		if info, err := os.Stat(config.Parameters.TxPoolJournal); err == nil {
+			samples = append(samples, metricSample{labels: `db="txpool_journal"`, value: float64(info.Size())})
+		}
+	}
+	mw.write("nknd_db_size_bytes", "gauge", "Size of the databases on disk.", samples...)
+
+	w.Header().Set("Content-Type", metricsContentType)
+	w.Write(mw.Bytes())
+}
@@ -0,0 +1,187 @@
+package server
+
+import (
+	"time"
+
+	"github.com/nknorg/nkn/api/common"
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/chain/pool"
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/log"
+)
+
+const (
+	mempoolEventAction = "mempoolEvent"
+	// txn of a mempool event is included in a block
+	mempoolEventIncluded = "included"
+)
+
+// mempoolFilter selects the mempool events pushed to a session. A nil field
+// matches all txns.
+type mempoolFilter struct {
+	sender *Uint160
+	txType *pb.PayloadType
+}
+
+func (f *mempoolFilter) match(sender Uint160, txType pb.PayloadType) bool {
+	if f.sender != nil && *f.sender != sender {
+		return false
+	}
+	if f.txType != nil && *f.txType != txType {
+		return false
+	}
+	return true
+}
+
+// subscribeMempool starts pushing txn pool events to the session, replacing
+// its previous filter if any.
+// params: {"Addr":<sender address, optional>, "TxType":<payload type, optional>}
+// return: {"Result":<filter>, "Error":<errcode>}
+func (ws *WsServer) subscribeMempool(s common.Serverer, cmd map[string]interface{}) map[string]interface{} {
+	filter := &mempoolFilter{}
+	result := make(map[string]interface{})
+
+	if addr, ok := cmd["Addr"].(string); ok && addr != "" {
+		sender, err := ToScriptHash(addr)
+		if err != nil {
+			return common.RespPacking(err.Error(), common.INVALID_PARAMS)
+		}
+		filter.sender = &sender
+		result["addr"] = addr
+	}
+
+	if cmd["TxType"] != nil {
+		name, ok := cmd["TxType"].(string)
+		if !ok {
+			return common.RespPacking("TxType should be a string", common.INVALID_PARAMS)
+		}
+		v, ok := pb.PayloadType_value[name]
+		if !ok {
+			return common.RespPacking("unknown TxType "+name, common.INVALID_PARAMS)
+		}
+		txType := pb.PayloadType(v)
+		filter.txType = &txType
+		result["txType"] = name
+	}
+
+	ws.mempoolLock.Lock()
+	ws.mempoolSubs[cmd["Userid"].(string)] = filter
+	ws.mempoolLock.Unlock()
+
+	return common.RespPacking(result, common.SUCCESS)
+}
+
+// unsubscribeMempool stops pushing txn pool events to the session.
+// params: {}
+// return: {"Result":<true>, "Error":<errcode>}
+func (ws *WsServer) unsubscribeMempool(s common.Serverer, cmd map[string]interface{}) map[string]interface{} {
+	ws.deleteMempoolSub(cmd["Userid"].(string))
+	return common.RespPacking(true, common.SUCCESS)
+}
+
+func (ws *WsServer) deleteMempoolSub(sSessionId string) {
+	ws.mempoolLock.Lock()
+	defer ws.mempoolLock.Unlock()
+	delete(ws.mempoolSubs, sSessionId)
+}
+
+func (ws *WsServer) hasMempoolSubs() bool {
+	ws.mempoolLock.RLock()
+	defer ws.mempoolLock.RUnlock()
+	return len(ws.mempoolSubs) > 0
+}
+
+func mempoolEventInfo(eventType string, txn *transaction.Transaction) (map[string]interface{}, Uint160, error) {
+	var sender Uint160
+	hashes, err := txn.GetProgramHashes()
+	if err != nil {
+		return nil, sender, err
+	}
+	if len(hashes) > 0 {
+		sender = hashes[0]
+	}
+	addr, err := sender.ToAddress()
+	if err != nil {
+		return nil, sender, err
+	}
+
+	txnHash := txn.Hash()
+	info := map[string]interface{}{
+		"event":  eventType,
+		"txHash": txnHash.ToHexString(),
+		"sender": addr,
+		"nonce":  txn.UnsignedTx.Nonce,
+		"fee":    txn.UnsignedTx.Fee,
+		"size":   txn.GetSize(),
+		"txType": txn.UnsignedTx.Payload.Type.String(),
+	}
+	return info, sender, nil
+}
+
+// pushMempoolEvent sends info to the sessions whose filter matches the txn.
+func (ws *WsServer) pushMempoolEvent(info map[string]interface{}, sender Uint160, txType pb.PayloadType) {
+	ws.mempoolLock.RLock()
+	sessionIds := make([]string, 0, len(ws.mempoolSubs))
+	for sSessionId, filter := range ws.mempoolSubs {
+		if filter.match(sender, txType) {
+			sessionIds = append(sessionIds, sSessionId)
+		}
+	}
+	ws.mempoolLock.RUnlock()
+
+	for _, sSessionId := range sessionIds {
+		resp := common.ResponsePack(common.SUCCESS)
+		resp["Action"] = mempoolEventAction
+		resp["Result"] = info
+		ws.respondToId(sSessionId, resp)
+	}
+}
+
+// onTxnPoolChanged pushes admission, replacement and eviction events of the
+// txn pool.
+func (ws *WsServer) onTxnPoolChanged(v interface{}) {
+	e, ok := v.(*pool.TxnEvent)
+	if !ok || !ws.hasMempoolSubs() {
+		return
+	}
+
+	info, sender, err := mempoolEventInfo(e.Type, e.Txn)
+	if err != nil {
+		log.Warningf("Build mempool event error: %v", err)
+		return
+	}
+	info["time"] = e.Time.UnixNano()
+	if e.Replaced != nil {
+		info["replacedTxHash"] = e.Replaced.ToHexString()
+	}
+	if e.Reason != "" {
+		info["reason"] = e.Reason
+	}
+
+	ws.pushMempoolEvent(info, sender, e.Txn.UnsignedTx.Payload.Type)
+}
+
+// onBlockPersisted pushes an inclusion event for each txn in the block.
+func (ws *WsServer) onBlockPersisted(v interface{}) {
+	b, ok := v.(*block.Block)
+	if !ok || !ws.hasMempoolSubs() {
+		return
+	}
+
+	height := b.Header.UnsignedHeader.Height
+	for _, txn := range b.Transactions {
+		if txn.UnsignedTx.Payload.Type == pb.COINBASE_TYPE {
+			continue
+		}
+		info, sender, err := mempoolEventInfo(mempoolEventIncluded, txn)
+		if err != nil {
+			log.Warningf("Build mempool event error: %v", err)
+			continue
+		}
+		info["height"] = height
+		info["time"] = time.Now().UnixNano()
+		ws.pushMempoolEvent(info, sender, txn.UnsignedTx.Payload.Type)
+	}
+}
@@ -52,6 +52,8 @@ type WsServer struct {
 	wallet        vault.Wallet
 	messageBuffer *messagebuffer.MessageBuffer
 	sigChainCache Cache
+	mempoolLock   sync.RWMutex
+	mempoolSubs   map[string]*mempoolFilter //key: sessionid
 }
 
 func InitWsServer(localNode *node.LocalNode, wallet vault.Wallet) *WsServer {
@@ -63,7 +65,10 @@ func InitWsServer(localNode *node.LocalNode, wallet vault.Wallet) *WsServer {
 		wallet:        wallet,
 		messageBuffer: messagebuffer.NewMessageBuffer(),
 		sigChainCache: NewGoCache(sigChainCacheExpiration, sigChainCacheCleanupInterval),
+		mempoolSubs:   make(map[string]*mempoolFilter),
 	}
+	event.Queue.Subscribe(event.TxnPoolChanged, ws.onTxnPoolChanged)
+	event.Queue.Subscribe(event.BlockPersistCompleted, ws.onBlockPersisted)
 	return ws
 }
 
@@ -198,6 +203,9 @@ func (ws *WsServer) registryMethod() {
 		"gettxhashmap":    {handler: gettxhashmap},
 		"getsessioncount": {handler: getsessioncount},
 		"setClient":       {handler: setClient},
+
+		"subscribemempool":   {handler: ws.subscribeMempool},
+		"unsubscribemempool": {handler: ws.unsubscribeMempool},
 	}
 
 	for name, handler := range common.InitialAPIHandlers {
@@ -267,6 +275,7 @@ func (ws *WsServer) websocketHandler(w http.ResponseWriter, r *http.Request) {
 
 	defer func() {
 		ws.deleteTxHashs(nsSession.GetSessionId())
+		ws.deleteMempoolSub(nsSession.GetSessionId())
 		ws.SessionList.CloseSession(nsSession)
 		if err := recover(); err != nil {
 			log.Error("websocket recover:", err)
@@ -296,6 +305,9 @@ func (ws *WsServer) IsValidMsg(reqMsg map[string]interface{}) bool {
 	if _, ok := reqMsg["Assetid"].(string); !ok && reqMsg["Assetid"] != nil {
 		return false
 	}
+	if _, ok := reqMsg["TxType"].(string); !ok && reqMsg["TxType"] != nil {
+		return false
+	}
 	return true
 }
 
@@ -64,6 +64,15 @@ func SetPushBlockTxsFlag(b bool) {
 	pushBlockTxsFlag = b
 }
 
+// GetSessionCount returns the number of websocket sessions, or 0 if the server
+// is not created.
+func GetSessionCount() int {
+	if ws == nil {
+		return 0
+	}
+	return ws.SessionList.GetSessionCount()
+}
+
 func SetTxHashMap(txhash string, sessionid string) {
 	if ws == nil {
 		return
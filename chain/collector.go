@@ -57,25 +57,43 @@ func (tc *TxnCollector) Cleanup(txns []*transaction.Transaction) error {
 	return tc.TxnSource.CleanSubmittedTransactions(txns)
 }
 
-type sortTxnsByPrice []*transaction.Transaction
+// pricedTxn caches the fee per byte of a txn, as computing the size of a txn
+// requires marshaling it.
+type pricedTxn struct {
+	txn        *transaction.Transaction
+	feePerByte float64
+}
+
+func newPricedTxn(txn *transaction.Transaction) *pricedTxn {
+	pt := &pricedTxn{txn: txn}
+	if size := txn.GetSize(); size > 0 {
+		pt.feePerByte = float64(txn.UnsignedTx.Fee) / float64(size)
+	}
+	return pt
+}
+
+type sortTxnsByPrice []*pricedTxn
 
 func (s sortTxnsByPrice) Len() int           { return len(s) }
-func (s sortTxnsByPrice) Less(i, j int) bool { return s[i].UnsignedTx.Fee > s[j].UnsignedTx.Fee }
+func (s sortTxnsByPrice) Less(i, j int) bool { return s[i].feePerByte > s[j].feePerByte }
 func (s sortTxnsByPrice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
 
+// TxnCollection hands out txns by fee per byte, highest first. Only the
+// lowest nonce txn of each sender competes at a time, so txns of the same
+// sender stay in nonce order.
 type TxnCollection struct {
 	txns map[Uint160][]*transaction.Transaction
-	tops []*transaction.Transaction
+	tops []*pricedTxn
 }
 
 func NewTxnCollection(txnLists map[Uint160][]*transaction.Transaction) *TxnCollection {
-	tops := make([]*transaction.Transaction, 0)
+	tops := make([]*pricedTxn, 0)
 	for addr, txnList := range txnLists {
-		tops = append(tops, txnList[0])
+		tops = append(tops, newPricedTxn(txnList[0]))
 		txnLists[addr] = txnList[1:]
 	}
 
-	sort.Sort(sort.Reverse(sortTxnsByPrice(tops)))
+	sort.Stable(sortTxnsByPrice(tops))
 
 	return &TxnCollection{
 		txns: txnLists,
@@ -87,17 +105,17 @@ func (tc *TxnCollection) Peek() *transaction.Transaction {
 	if len(tc.tops) == 0 {
 		return nil
 	}
-	return tc.tops[0]
+	return tc.tops[0].txn
 }
 
 func (tc *TxnCollection) Update() error {
-	hashes, err := tc.tops[0].GetProgramHashes()
+	hashes, err := tc.tops[0].txn.GetProgramHashes()
 	if err != nil {
 		return err
 	}
 	if txnList, ok := tc.txns[hashes[0]]; ok && len(txnList) > 0 {
-		tc.tops[0], tc.txns[hashes[0]] = txnList[0], txnList[1:]
-		sort.Sort(sort.Reverse(sortTxnsByPrice(tc.tops)))
+		tc.tops[0], tc.txns[hashes[0]] = newPricedTxn(txnList[0]), txnList[1:]
+		sort.Stable(sortTxnsByPrice(tc.tops))
 	} else {
 		tc.tops = tc.tops[1:]
 	}
@@ -0,0 +1,132 @@
+package chain
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+)
+
+const (
+	benchSenders       = 512
+	benchTxnsPerSender = 4
+	benchBlockSize     = 64 * 1024
+)
+
+// newBenchTxnLists creates nonce ordered transfers of many senders with
+// random fees and sizes between about 150 and 800 bytes.
+func newBenchTxnLists() map[Uint160][]*transaction.Transaction {
+	rnd := rand.New(rand.NewSource(1))
+	lists := make(map[Uint160][]*transaction.Transaction, benchSenders)
+	for i := 0; i < benchSenders; i++ {
+		var sender Uint160
+		rnd.Read(sender[:])
+		for nonce := uint64(0); nonce < benchTxnsPerSender; nonce++ {
+			fee := Fixed64(rnd.Int63n(10 * StorageFactor))
+			txn, err := transaction.NewTransferAssetTransaction(sender, sender, config.NKNAssetID, nonce, 1, fee)
+			if err != nil {
+				panic(err)
+			}
+			txn.UnsignedTx.Attributes = make([]byte, rnd.Intn(100))
+			param := make([]byte, 64*(1+rnd.Intn(8)))
+			rnd.Read(param)
+			txn.Programs = []*pb.Program{{Code: make([]byte, 35), Parameter: param}}
+			lists[sender] = append(lists[sender], txn)
+		}
+	}
+	return lists
+}
+
+func copyTxnLists(lists map[Uint160][]*transaction.Transaction) map[Uint160][]*transaction.Transaction {
+	c := make(map[Uint160][]*transaction.Transaction, len(lists))
+	for k, v := range lists {
+		c[k] = v
+	}
+	return c
+}
+
+// packByFee fills a block the way BuildBlock does, ranking the head txn of
+// each sender by absolute fee.
+func packByFee(lists map[Uint160][]*transaction.Transaction, maxSize int) (fee int64) {
+	heads := make([]*transaction.Transaction, 0, len(lists))
+	for addr, list := range lists {
+		heads = append(heads, list[0])
+		lists[addr] = list[1:]
+	}
+
+	size := 0
+	for len(heads) > 0 {
+		sort.SliceStable(heads, func(i, j int) bool { return heads[i].UnsignedTx.Fee > heads[j].UnsignedTx.Fee })
+		txn := heads[0]
+		if size += txn.GetSize(); size > maxSize {
+			break
+		}
+		fee += txn.UnsignedTx.Fee
+
+		hashes, _ := txn.GetProgramHashes()
+		if list := lists[hashes[0]]; len(list) > 0 {
+			heads[0], lists[hashes[0]] = list[0], list[1:]
+		} else {
+			heads = heads[1:]
+		}
+	}
+	return fee
+}
+
+func packByCollection(lists map[Uint160][]*transaction.Transaction, maxSize int) (fee int64) {
+	collection := NewTxnCollection(lists)
+	size := 0
+	for txn := collection.Peek(); txn != nil; txn = collection.Peek() {
+		if size += txn.GetSize(); size > maxSize {
+			break
+		}
+		fee += txn.UnsignedTx.Fee
+		if err := collection.Update(); err != nil {
+			collection.Pop()
+		}
+	}
+	return fee
+}
+
+func TestTxnCollectionNonceOrder(t *testing.T) {
+	lists := newBenchTxnLists()
+	next := make(map[Uint160]uint64)
+	collection := NewTxnCollection(copyTxnLists(lists))
+	count := 0
+	for txn := collection.Peek(); txn != nil; txn = collection.Peek() {
+		hashes, _ := txn.GetProgramHashes()
+		if txn.UnsignedTx.Nonce != next[hashes[0]] {
+			t.Fatalf("got nonce %d of sender, want %d", txn.UnsignedTx.Nonce, next[hashes[0]])
+		}
+		next[hashes[0]]++
+		count++
+		collection.Update()
+	}
+	if count != benchSenders*benchTxnsPerSender {
+		t.Fatalf("collected %d txns, want %d", count, benchSenders*benchTxnsPerSender)
+	}
+}
+
+func BenchmarkCollectByFee(b *testing.B) {
+	lists := newBenchTxnLists()
+	b.ResetTimer()
+	var fee int64
+	for i := 0; i < b.N; i++ {
+		fee = packByFee(copyTxnLists(lists), benchBlockSize)
+	}
+	b.ReportMetric(float64(fee)/StorageFactor, "fee/block")
+}
+
+func BenchmarkCollectByFeePerByte(b *testing.B) {
+	lists := newBenchTxnLists()
+	b.ResetTimer()
+	var fee int64
+	for i := 0; i < b.N; i++ {
+		fee = packByCollection(copyTxnLists(lists), benchBlockSize)
+	}
+	b.ReportMetric(float64(fee)/StorageFactor, "fee/block")
+}
@@ -0,0 +1,210 @@
+package db
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/chain"
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/crypto"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/program"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/log"
+)
+
+var ErrAddressIndexDisabled = errors.New("transaction index by address is disabled")
+
+func publicKeyToProgramHash(pubkey []byte) (Uint160, error) {
+	publicKey, err := crypto.NewPubKeyFromBytes(pubkey)
+	if err != nil {
+		return EmptyUint160, err
+	}
+	return program.CreateProgramHash(publicKey)
+}
+
+// getTxnAddresses returns the program hashes of all addresses a transaction
+// is related to, the senders first.
+func getTxnAddresses(txn *transaction.Transaction) ([]Uint160, error) {
+	addrs, err := txn.GetProgramHashes()
+	if err != nil {
+		return nil, err
+	}
+
+	pl, err := transaction.Unpack(txn.UnsignedTx.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	switch txn.UnsignedTx.Payload.Type {
+	case pb.COINBASE_TYPE:
+		addrs = append(addrs, BytesToUint160(pl.(*pb.Coinbase).Recipient))
+	case pb.TRANSFER_ASSET_TYPE:
+		addrs = append(addrs, BytesToUint160(pl.(*pb.TransferAsset).Recipient))
+	case pb.BATCH_TRANSFER_ASSET_TYPE:
+		for _, output := range pl.(*pb.BatchTransferAsset).Outputs {
+			addrs = append(addrs, BytesToUint160(output.Recipient))
+		}
+	case pb.NANO_PAY_TYPE:
+		addrs = append(addrs, BytesToUint160(pl.(*pb.NanoPay).Recipient))
+	case pb.TRANSFER_NAME_TYPE:
+		recipient, err := publicKeyToProgramHash(pl.(*pb.TransferName).Recipient)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, recipient)
+	}
+
+	unique := make([]Uint160, 0, len(addrs))
+	seen := make(map[Uint160]struct{}, len(addrs))
+	for _, addr := range addrs {
+		if _, ok := seen[addr]; ok {
+			continue
+		}
+		seen[addr] = struct{}{}
+		unique = append(unique, addr)
+	}
+
+	return unique, nil
+}
+
+// initAddressIndex loads the height the address index starts at. Blocks
+// persisted while the index is disabled are not indexed, so enabling it on a
+// db that already has blocks starts it at the next block, and disabling it
+// discards the start height so that enabling it again starts over.
+func (cs *ChainStore) initAddressIndex() error {
+	if !config.Parameters.TxIndexByAddress {
+		if err := cs.st.Delete(addressIndexStartKey()); err != nil && err != ErrReadOnly {
+			return err
+		}
+		return nil
+	}
+
+	data, err := cs.st.Get(addressIndexStartKey())
+	if err == nil && len(data) == 4 {
+		cs.addressIndexStart = binary.LittleEndian.Uint32(data)
+		return nil
+	}
+
+	log.Infof("Transaction index by address starts at height %d", cs.currentBlockHeight+1)
+
+	return cs.setAddressIndexStart(cs.currentBlockHeight + 1)
+}
+
+// setAddressIndexStart sets the height of the first block in the address
+// index. A read-only store only keeps it in memory.
+func (cs *ChainStore) setAddressIndexStart(height uint32) error {
+	cs.mu.Lock()
+	cs.addressIndexStart = height
+	cs.mu.Unlock()
+
+	data := make([]byte, 4)
+	binary.LittleEndian.PutUint32(data, height)
+	if err := cs.st.Put(addressIndexStartKey(), data); err != nil && err != ErrReadOnly {
+		return err
+	}
+
+	return nil
+}
+
+func (cs *ChainStore) persistAddressIndex(b *block.Block) error {
+	if !config.Parameters.TxIndexByAddress {
+		return nil
+	}
+
+	for i, txn := range b.Transactions {
+		addrs, err := getTxnAddresses(txn)
+		if err != nil {
+			return err
+		}
+
+		txHash := txn.Hash()
+		for _, addr := range addrs {
+			key := addressTransactionKey(addr, b.Header.UnsignedHeader.Height, uint32(i))
+			if err := cs.st.BatchPut(key, txHash.ToArray()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (cs *ChainStore) rollbackAddressIndex(b *block.Block) error {
+	if !config.Parameters.TxIndexByAddress {
+		return nil
+	}
+
+	for i, txn := range b.Transactions {
+		addrs, err := getTxnAddresses(txn)
+		if err != nil {
+			return err
+		}
+
+		for _, addr := range addrs {
+			key := addressTransactionKey(addr, b.Header.UnsignedHeader.Height, uint32(i))
+			if err := cs.st.BatchDelete(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetAddressIndexStart returns the height of the first block in the address
+// index. Transactions in blocks before it are not indexed.
+func (cs *ChainStore) GetAddressIndexStart() uint32 {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+	return cs.addressIndexStart
+}
+
+// GetTxnsByAddress returns the transactions related to an address, newest
+// first, skipping the first offset ones and returning at most limit.
+// Transactions in blocks before GetAddressIndexStart are not included.
+func (cs *ChainStore) GetTxnsByAddress(programHash Uint160, offset, limit int) ([]*chain.AddressTxn, error) {
+	if !config.Parameters.TxIndexByAddress {
+		return nil, ErrAddressIndexDisabled
+	}
+
+	indexStart := cs.GetAddressIndexStart()
+
+	prefix := addressTransactionPrefix(programHash)
+	iter := cs.st.NewIterator(prefix)
+	defer iter.Release()
+
+	txns := make([]*chain.AddressTxn, 0)
+	for ok := iter.Last(); ok && len(txns) < limit; ok = iter.Prev() {
+		key := iter.Key()[len(prefix):]
+		if len(key) != 8 {
+			return nil, errors.New("invalid address index key")
+		}
+
+		height := binary.BigEndian.Uint32(key[:4])
+		if height < indexStart {
+			// left from an earlier time the index was enabled
+			break
+		}
+
+		if offset > 0 {
+			offset--
+			continue
+		}
+
+		hash, err := Uint256ParseFromBytes(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		txns = append(txns, &chain.AddressTxn{
+			Hash:   hash,
+			Height: height,
+			Index:  binary.BigEndian.Uint32(key[4:]),
+		})
+	}
+
+	return txns, nil
+}
@@ -0,0 +1,124 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+const (
+	LevelDBBackend = "leveldb"
+	MemoryBackend  = "memory"
+)
+
+var (
+	ErrNotFound = leveldb.ErrNotFound
+	ErrReadOnly = errors.New("store is opened read-only")
+)
+
+// StoreOpener opens the store located at path. A store opened with readOnly
+// set must not modify path, and must be safe to open while another process
+// is writing to it.
+type StoreOpener func(path string, readOnly bool) (IStore, error)
+
+var (
+	storesLock sync.RWMutex
+	stores     = make(map[string]StoreOpener)
+)
+
+func init() {
+	RegisterStore(LevelDBBackend, func(path string, readOnly bool) (IStore, error) {
+		if readOnly {
+			return NewReadOnlyLevelDBStore(path)
+		}
+		return NewLevelDBStore(path)
+	})
+	RegisterStore(MemoryBackend, func(path string, readOnly bool) (IStore, error) {
+		if readOnly {
+			return nil, errors.New("memory store has nothing to open read-only")
+		}
+		return NewMemStore(), nil
+	})
+}
+
+// RegisterStore makes a store backend available under name. It panics if
+// name is already registered.
+func RegisterStore(name string, opener StoreOpener) {
+	storesLock.Lock()
+	defer storesLock.Unlock()
+
+	if opener == nil {
+		panic("db: RegisterStore opener is nil")
+	}
+	if _, ok := stores[name]; ok {
+		panic("db: RegisterStore called twice for backend " + name)
+	}
+	stores[name] = opener
+}
+
+// StoreBackends returns the names of the registered store backends, sorted.
+func StoreBackends() []string {
+	storesLock.RLock()
+	defer storesLock.RUnlock()
+
+	names := make([]string, 0, len(stores))
+	for name := range stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// OpenStore opens the store at path with the backend registered as name.
+// Writes to a store opened read-only fail with ErrReadOnly.
+func OpenStore(name, path string, readOnly bool) (IStore, error) {
+	storesLock.RLock()
+	opener, ok := stores[name]
+	storesLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown store backend %q, available: %v", name, StoreBackends())
+	}
+
+	st, err := opener(path, readOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	if readOnly {
+		return &readOnlyStore{IStore: st}, nil
+	}
+	return st, nil
+}
+
+// readOnlyStore rejects every write to the underlying store, so a write
+// attempt fails right away instead of when a batch is committed.
+type readOnlyStore struct {
+	IStore
+}
+
+func (ros *readOnlyStore) Put(key []byte, value []byte) error {
+	return ErrReadOnly
+}
+
+func (ros *readOnlyStore) Delete(key []byte) error {
+	return ErrReadOnly
+}
+
+func (ros *readOnlyStore) NewBatch() error {
+	return ErrReadOnly
+}
+
+func (ros *readOnlyStore) BatchPut(key []byte, value []byte) error {
+	return ErrReadOnly
+}
+
+func (ros *readOnlyStore) BatchDelete(key []byte) error {
+	return ErrReadOnly
+}
+
+func (ros *readOnlyStore) BatchCommit() error {
+	return ErrReadOnly
+}
@@ -0,0 +1,128 @@
+package db
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/filter"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// number of times a checkpoint is retried when the running node removes files
+// while they are being copied
+const checkpointRetries = 5
+
+// NewReadOnlyLevelDBStore opens the LevelDB database at file without writing
+// to it. If the database is locked by a running node, a checkpoint is taken
+// into a temporary directory next to it and opened instead; the checkpoint is
+// removed when the store is closed.
+func NewReadOnlyLevelDBStore(file string) (*LevelDBStore, error) {
+	o := opt.Options{
+		ReadOnly:       true,
+		ErrorIfMissing: true,
+		Filter:         filter.NewBloomFilter(BITSPERKEY),
+	}
+
+	if _, err := os.Stat(file); err != nil {
+		return nil, err
+	}
+
+	db, err := leveldb.OpenFile(file, &o)
+	if err == nil {
+		return &LevelDBStore{db: db}, nil
+	}
+
+	for i := 0; i < checkpointRetries; i++ {
+		var dir string
+		dir, err = checkpointLevelDB(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		db, err = leveldb.OpenFile(dir, &o)
+		if err != nil {
+			os.RemoveAll(dir)
+			continue
+		}
+
+		return &LevelDBStore{db: db, checkpoint: dir}, nil
+	}
+
+	return nil, err
+}
+
+// checkpointLevelDB copies the LevelDB database at src into a new directory
+// and returns its path. Table files are never modified once written, so they
+// are hard linked when possible. The manifest is copied before tables so every
+// table it references is either copied or reported missing.
+func checkpointLevelDB(src string) (dir string, err error) {
+	dir, err = ioutil.TempDir(filepath.Dir(filepath.Clean(src)), filepath.Base(src)+".readonly-")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err != nil {
+			os.RemoveAll(dir)
+		}
+	}()
+
+	current, err := ioutil.ReadFile(filepath.Join(src, "CURRENT"))
+	if err != nil {
+		return "", err
+	}
+	manifest := strings.TrimSpace(string(current))
+	if err = copyFile(filepath.Join(src, manifest), filepath.Join(dir, manifest)); err != nil {
+		return "", err
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, "CURRENT"), current, 0644); err != nil {
+		return "", err
+	}
+
+	files, err := ioutil.ReadDir(src)
+	if err != nil {
+		return "", err
+	}
+	for _, fi := range files {
+		name := fi.Name()
+		switch {
+		case strings.HasSuffix(name, ".ldb") || strings.HasSuffix(name, ".sst"):
+			if err = os.Link(filepath.Join(src, name), filepath.Join(dir, name)); err != nil && !os.IsNotExist(err) {
+				err = copyFile(filepath.Join(src, name), filepath.Join(dir, name))
+			}
+		case strings.HasSuffix(name, ".log"):
+			err = copyFile(filepath.Join(src, name), filepath.Join(dir, name))
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return dir, nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+
+	return out.Close()
+}
@@ -1,6 +1,8 @@
 package db
 
 import (
+	"os"
+
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/errors"
 	"github.com/syndtr/goleveldb/leveldb/filter"
@@ -22,8 +24,9 @@ type IStore interface {
 }
 
 type LevelDBStore struct {
-	db    *leveldb.DB // LevelDB instance
-	batch *leveldb.Batch
+	db         *leveldb.DB // LevelDB instance
+	batch      *leveldb.Batch
+	checkpoint string // temporary copy opened read-only, removed on close
 }
 
 // used to compute the size of bloom filter bits array .
@@ -95,6 +98,9 @@ func (self *LevelDBStore) BatchCommit() error {
 
 func (self *LevelDBStore) Close() error {
 	err := self.db.Close()
+	if self.checkpoint != "" {
+		os.RemoveAll(self.checkpoint)
+	}
 	return err
 }
 
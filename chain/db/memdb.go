@@ -0,0 +1,214 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MemStore is an in-memory IStore. It keeps everything in a map and is meant
+// for tests and ephemeral networks. Iterators see a snapshot of the store
+// taken when they are created, like LevelDB iterators.
+type MemStore struct {
+	mu    sync.RWMutex
+	db    map[string][]byte
+	batch []memBatchOp
+}
+
+type memBatchOp struct {
+	key    string
+	value  []byte
+	delete bool
+}
+
+func NewMemStore() *MemStore {
+	return &MemStore{
+		db: make(map[string][]byte),
+	}
+}
+
+func copyBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
+
+func (ms *MemStore) Put(key []byte, value []byte) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.db[string(key)] = copyBytes(value)
+	return nil
+}
+
+func (ms *MemStore) Get(key []byte) ([]byte, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	value, ok := ms.db[string(key)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return copyBytes(value), nil
+}
+
+func (ms *MemStore) Has(key []byte) (bool, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	_, ok := ms.db[string(key)]
+	return ok, nil
+}
+
+func (ms *MemStore) Delete(key []byte) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	delete(ms.db, string(key))
+	return nil
+}
+
+func (ms *MemStore) NewBatch() error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.batch = make([]memBatchOp, 0)
+	return nil
+}
+
+func (ms *MemStore) BatchPut(key []byte, value []byte) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if ms.batch == nil {
+		return errors.New("no batch to put to")
+	}
+	ms.batch = append(ms.batch, memBatchOp{key: string(key), value: copyBytes(value)})
+	return nil
+}
+
+func (ms *MemStore) BatchDelete(key []byte) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if ms.batch == nil {
+		return errors.New("no batch to delete from")
+	}
+	ms.batch = append(ms.batch, memBatchOp{key: string(key), delete: true})
+	return nil
+}
+
+func (ms *MemStore) BatchCommit() error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if ms.batch == nil {
+		return errors.New("no batch to commit")
+	}
+	for _, op := range ms.batch {
+		if op.delete {
+			delete(ms.db, op.key)
+		} else {
+			ms.db[op.key] = op.value
+		}
+	}
+	return nil
+}
+
+func (ms *MemStore) Close() error {
+	return nil
+}
+
+func (ms *MemStore) NewIterator(prefix []byte) IIterator {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	keys := make([]string, 0)
+	for key := range ms.db {
+		if strings.HasPrefix(key, string(prefix)) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	values := make([][]byte, len(keys))
+	for i, key := range keys {
+		values[i] = ms.db[key]
+	}
+
+	return &memIterator{
+		keys:   keys,
+		values: values,
+		index:  -1,
+	}
+}
+
+type memIterator struct {
+	keys   []string
+	values [][]byte
+	index  int
+}
+
+func (it *memIterator) valid() bool {
+	return it.index >= 0 && it.index < len(it.keys)
+}
+
+func (it *memIterator) Next() bool {
+	if it.index < len(it.keys) {
+		it.index++
+	}
+	return it.valid()
+}
+
+func (it *memIterator) Prev() bool {
+	if it.index == len(it.keys) || it.index < 0 {
+		// like LevelDB, Prev on an exhausted or unpositioned iterator moves to
+		// the last key
+		it.index = len(it.keys) - 1
+		return it.valid()
+	}
+	it.index--
+	return it.valid()
+}
+
+func (it *memIterator) First() bool {
+	it.index = 0
+	return it.valid()
+}
+
+func (it *memIterator) Last() bool {
+	it.index = len(it.keys) - 1
+	return it.valid()
+}
+
+func (it *memIterator) Seek(key []byte) bool {
+	it.index = sort.Search(len(it.keys), func(i int) bool {
+		return bytes.Compare([]byte(it.keys[i]), key) >= 0
+	})
+	return it.valid()
+}
+
+func (it *memIterator) Key() []byte {
+	if !it.valid() {
+		return nil
+	}
+	return []byte(it.keys[it.index])
+}
+
+func (it *memIterator) Value() []byte {
+	if !it.valid() {
+		return nil
+	}
+	return it.values[it.index]
+}
+
+func (it *memIterator) Release() {
+	it.keys = nil
+	it.values = nil
+	it.index = -1
+}
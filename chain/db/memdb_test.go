@@ -0,0 +1,63 @@
+package db
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestMemStore(t *testing.T) {
+	st, err := OpenStore(MemoryBackend, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	st.Put([]byte("a1"), []byte("1"))
+	st.Put([]byte("b1"), []byte("2"))
+	if _, err := st.Get([]byte("a2")); err != ErrNotFound {
+		t.Fatalf("get missing key: got %v, want %v", err, ErrNotFound)
+	}
+
+	if err := st.BatchPut([]byte("a2"), []byte("3")); err == nil {
+		t.Fatal("batch put without batch succeeded")
+	}
+	st.NewBatch()
+	st.BatchPut([]byte("a3"), []byte("4"))
+	st.BatchPut([]byte("a2"), []byte("3"))
+	st.BatchDelete([]byte("b1"))
+	if ok, _ := st.Has([]byte("a2")); ok {
+		t.Fatal("batch visible before commit")
+	}
+	if err := st.BatchCommit(); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := st.Has([]byte("b1")); ok {
+		t.Fatal("batch delete not applied")
+	}
+
+	iter := st.NewIterator([]byte("a"))
+	st.Put([]byte("a0"), []byte("0"))
+	keys := make([]string, 0)
+	for iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	if len(keys) != 3 || keys[0] != "a1" || keys[1] != "a2" || keys[2] != "a3" {
+		t.Fatalf("iterate: got %v", keys)
+	}
+	if !iter.Prev() || string(iter.Key()) != "a3" {
+		t.Fatal("prev after exhausted iterator should move to last key")
+	}
+	if !iter.Seek([]byte("a2")) || !bytes.Equal(iter.Value(), []byte("3")) {
+		t.Fatal("seek to a2 failed")
+	}
+	if !iter.Prev() || string(iter.Key()) != "a1" || iter.Prev() {
+		t.Fatal("prev from a2 failed")
+	}
+	if !iter.Last() || string(iter.Key()) != "a3" {
+		t.Fatal("last failed")
+	}
+	iter.Release()
+
+	if _, err := OpenStore("unknown", "", false); err == nil {
+		t.Fatal("opened unknown backend")
+	}
+}
@@ -1,9 +1,48 @@
 package db
 
 import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
 	"strings"
+
+	"github.com/nknorg/nkn/common/serialization"
 )
 
+type nameInfo struct {
+	registrant []byte
+	expiresAt  uint32
+}
+
+func (ni *nameInfo) Serialize(w io.Writer) error {
+	if err := serialization.WriteVarBytes(w, ni.registrant); err != nil {
+		return err
+	}
+
+	if err := serialization.WriteUint32(w, ni.expiresAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ni *nameInfo) Deserialize(r io.Reader) error {
+	var err error
+
+	ni.registrant, err = serialization.ReadVarBytes(r)
+	if err != nil {
+		return err
+	}
+
+	ni.expiresAt, err = serialization.ReadUint32(r)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func getRegistrantId(registrant []byte) string {
 	return string(registrant)
 }
@@ -12,11 +51,22 @@ func getNameId(name string) string {
 	return strings.ToLower(name)
 }
 
-func (sdb *StateDB) updateName(registrant []byte, name string) error {
-	registrantId := getRegistrantId(registrant)
+func getNameCleanupId(height uint32) []byte {
+	buf := new(bytes.Buffer)
+	_ = serialization.WriteUint32(buf, height)
+	return buf.Bytes()
+}
+
+func (sdb *StateDB) updateName(name string, info *nameInfo) error {
+	registrantId := getRegistrantId(info.registrant)
 	nameId := getNameId(name)
 
-	err := sdb.trie.TryUpdate(append(NamePrefix, nameId...), registrant)
+	buff := bytes.NewBuffer(nil)
+	if err := info.Serialize(buff); err != nil {
+		panic(fmt.Errorf("can't encode name info %v: %v", info, err))
+	}
+
+	err := sdb.trie.TryUpdate(append(NamePrefix, nameId...), buff.Bytes())
 	if err != nil {
 		return err
 	}
@@ -24,12 +74,32 @@ func (sdb *StateDB) updateName(registrant []byte, name string) error {
 	return sdb.trie.TryUpdate(append(NameRegistrantPrefix, registrantId...), []byte(name))
 }
 
-func (sdb *StateDB) setName(registrant []byte, name string) {
+func (sdb *StateDB) setName(registrant []byte, name string, expiresAt uint32) error {
 	registrantId := getRegistrantId(registrant)
 	nameId := getNameId(name)
 
+	info, err := sdb.getNameInfo(name)
+	if err != nil {
+		return err
+	}
+
+	if info != nil {
+		if err := sdb.cancelNameCleanupAtHeight(info.expiresAt, nameId); err != nil {
+			return err
+		}
+	}
+
+	if err := sdb.cleanupNameAtHeight(expiresAt, nameId); err != nil {
+		return err
+	}
+
 	sdb.names[registrantId] = name
-	sdb.nameRegistrants[nameId] = registrant
+	sdb.nameRegistrants[nameId] = &nameInfo{
+		registrant: registrant,
+		expiresAt:  expiresAt,
+	}
+
+	return nil
 }
 
 func (sdb *StateDB) deleteName(registrantId string) error {
@@ -44,23 +114,79 @@ func (sdb *StateDB) deleteName(registrantId string) error {
 		return err
 	}
 
-	err = sdb.trie.TryDelete(append(NamePrefix, nameId...))
+	// the name may have been transferred to another registrant already
+	info, err := sdb.getNameInfoFromTrie(nameId)
 	if err != nil {
 		return err
 	}
 
+	if info != nil && getRegistrantId(info.registrant) == registrantId {
+		err = sdb.trie.TryDelete(append(NamePrefix, nameId...))
+		if err != nil {
+			return err
+		}
+	}
+
 	delete(sdb.names, registrantId)
-	delete(sdb.nameRegistrants, nameId)
+	if cached, ok := sdb.nameRegistrants[nameId]; ok && (cached == nil || getRegistrantId(cached.registrant) == registrantId) {
+		delete(sdb.nameRegistrants, nameId)
+	}
 
 	return nil
 }
 
-func (sdb *StateDB) deleteNameForRegistrant(registrant []byte, name string) {
+func (sdb *StateDB) deleteNameForRegistrant(registrant []byte, name string) error {
 	registrantId := getRegistrantId(registrant)
 	nameId := getNameId(name)
 
+	info, err := sdb.getNameInfo(name)
+	if err != nil {
+		return err
+	}
+
+	if info != nil {
+		if err := sdb.cancelNameCleanupAtHeight(info.expiresAt, nameId); err != nil {
+			return err
+		}
+	}
+
 	sdb.names[registrantId] = ""
 	sdb.nameRegistrants[nameId] = nil
+
+	return nil
+}
+
+func (sdb *StateDB) transferName(registrant, recipient []byte, name string) error {
+	currentName, err := sdb.getName(registrant)
+	if err != nil {
+		return err
+	}
+
+	if getNameId(currentName) != getNameId(name) {
+		return fmt.Errorf("name %s is not registered for registrant %x", name, registrant)
+	}
+
+	info, err := sdb.getNameInfo(currentName)
+	if err != nil {
+		return err
+	}
+
+	if info == nil {
+		return fmt.Errorf("name %s is not registered", name)
+	}
+
+	registrantId := getRegistrantId(registrant)
+	recipientId := getRegistrantId(recipient)
+	nameId := getNameId(currentName)
+
+	sdb.names[registrantId] = ""
+	sdb.names[recipientId] = currentName
+	sdb.nameRegistrants[nameId] = &nameInfo{
+		registrant: recipient,
+		expiresAt:  info.expiresAt,
+	}
+
+	return nil
 }
 
 func (sdb *StateDB) getName(registrant []byte) (string, error) {
@@ -75,35 +201,63 @@ func (sdb *StateDB) getName(registrant []byte) (string, error) {
 
 		if len(enc) > 0 {
 			name = string(enc)
-			nameId := getNameId(name)
 			sdb.names[registrantId] = name
-			sdb.nameRegistrants[nameId] = registrant
 		}
 	}
 
 	return name, nil
 }
 
-func (sdb *StateDB) getRegistrant(name string) ([]byte, error) {
+func (sdb *StateDB) getNameInfoFromTrie(nameId string) (*nameInfo, error) {
+	enc, err := sdb.trie.TryGet(append(NamePrefix, nameId...))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(enc) == 0 {
+		return nil, nil
+	}
+
+	info := &nameInfo{}
+	if err := info.Deserialize(bytes.NewBuffer(enc)); err != nil {
+		return nil, fmt.Errorf("[getNameInfo]Failed to decode state object for name: %v", err)
+	}
+
+	return info, nil
+}
+
+func (sdb *StateDB) getNameInfo(name string) (*nameInfo, error) {
 	nameId := getNameId(name)
-	var registrant []byte
+	var info *nameInfo
 	var ok bool
-	if registrant, ok = sdb.nameRegistrants[nameId]; !ok {
-		enc, err := sdb.trie.TryGet(append(NamePrefix, nameId...))
+	if info, ok = sdb.nameRegistrants[nameId]; !ok {
+		var err error
+		info, err = sdb.getNameInfoFromTrie(nameId)
 		if err != nil {
 			return nil, err
 		}
 
-		if len(enc) > 0 {
-			registrant = enc
-
-			registrantId := getRegistrantId(registrant)
+		if info != nil {
+			registrantId := getRegistrantId(info.registrant)
 			sdb.names[registrantId] = name
-			sdb.nameRegistrants[nameId] = registrant
+			sdb.nameRegistrants[nameId] = info
 		}
 	}
 
-	return registrant, nil
+	return info, nil
+}
+
+func (sdb *StateDB) getRegistrant(name string) ([]byte, uint32, error) {
+	info, err := sdb.getNameInfo(name)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if info == nil {
+		return nil, 0, nil
+	}
+
+	return info.registrant, info.expiresAt, nil
 }
 
 func (cs *ChainStore) GetName(registrant []byte) (string, error) {
@@ -111,23 +265,152 @@ func (cs *ChainStore) GetName(registrant []byte) (string, error) {
 }
 
 func (cs *ChainStore) GetRegistrant(name string) ([]byte, error) {
-	return cs.States.getRegistrant(name)
+	registrant, _, err := cs.States.getRegistrant(name)
+	return registrant, err
+}
+
+func (cs *ChainStore) GetNameExpiresAt(name string) (uint32, error) {
+	_, expiresAt, err := cs.States.getRegistrant(name)
+	return expiresAt, err
+}
+
+func (sdb *StateDB) getNameCleanup(height uint32) (map[string]struct{}, error) {
+	var nc map[string]struct{}
+	var ok bool
+	if nc, ok = sdb.nameCleanup[height]; !ok {
+		enc, err := sdb.trie.TryGet(append(NameCleanupPrefix, getNameCleanupId(height)...))
+		if err != nil {
+			return nil, fmt.Errorf("[getNameCleanup]can not get name cleanup from trie: %v", err)
+		}
+
+		nc = make(map[string]struct{}, 0)
+
+		if len(enc) > 0 {
+			buff := bytes.NewBuffer(enc)
+			ncLength, err := serialization.ReadVarUint(buff, 0)
+			if err != nil {
+				return nil, fmt.Errorf("[getNameCleanup]Failed to decode state object for name cleanup: %v", err)
+			}
+			for i := uint64(0); i < ncLength; i++ {
+				id, err := serialization.ReadVarString(buff)
+				if err != nil {
+					return nil, fmt.Errorf("[getNameCleanup]Failed to decode state object for name cleanup: %v", err)
+				}
+				nc[id] = struct{}{}
+			}
+		}
+
+		sdb.nameCleanup[height] = nc
+	}
+
+	return nc, nil
+}
+
+func (sdb *StateDB) cleanupNameAtHeight(height uint32, nameId string) error {
+	ids, err := sdb.getNameCleanup(height)
+	if err != nil {
+		return err
+	}
+	ids[nameId] = struct{}{}
+	return nil
+}
+
+func (sdb *StateDB) cancelNameCleanupAtHeight(height uint32, nameId string) error {
+	ids, err := sdb.getNameCleanup(height)
+	if err != nil {
+		return err
+	}
+	if _, ok := ids[nameId]; ok {
+		delete(ids, nameId)
+	}
+	return nil
+}
+
+func (sdb *StateDB) deleteNameCleanup(height uint32) error {
+	err := sdb.trie.TryDelete(append(NameCleanupPrefix, getNameCleanupId(height)...))
+	if err != nil {
+		return err
+	}
+
+	delete(sdb.nameCleanup, height)
+	return nil
+}
+
+func (sdb *StateDB) updateNameCleanup(height uint32, nc map[string]struct{}) error {
+	buff := bytes.NewBuffer(nil)
+
+	if err := serialization.WriteVarUint(buff, uint64(len(nc))); err != nil {
+		panic(fmt.Errorf("can't encode name cleanup %v: %v", nc, err))
+	}
+	ncs := make([]string, 0)
+	for id := range nc {
+		ncs = append(ncs, id)
+	}
+	sort.Strings(ncs)
+	for _, id := range ncs {
+		if err := serialization.WriteVarString(buff, id); err != nil {
+			panic(fmt.Errorf("can't encode name cleanup %v: %v", nc, err))
+		}
+	}
+
+	return sdb.trie.TryUpdate(append(NameCleanupPrefix, getNameCleanupId(height)...), buff.Bytes())
+}
+
+func (sdb *StateDB) CleanupNames(height uint32) error {
+	ids, err := sdb.getNameCleanup(height)
+	if err != nil {
+		return err
+	}
+	for nameId := range ids {
+		info, err := sdb.getNameInfo(nameId)
+		if err != nil {
+			return err
+		}
+		if info == nil {
+			continue
+		}
+		sdb.names[getRegistrantId(info.registrant)] = ""
+		sdb.nameRegistrants[nameId] = nil
+	}
+	sdb.nameCleanup[height] = nil
+
+	return nil
 }
 
 func (sdb *StateDB) FinalizeNames(commit bool) {
+	// deletions go first so that a transferred name is not removed from its
+	// new registrant
 	for registrantId, name := range sdb.names {
 		if name == "" {
 			sdb.deleteName(registrantId)
-		} else {
-			nameId := getNameId(name)
-			registrant := sdb.nameRegistrants[nameId]
-			sdb.updateName(registrant, name)
 			if commit {
-				delete(sdb.nameRegistrants, nameId)
+				delete(sdb.names, registrantId)
 			}
 		}
+	}
+
+	for registrantId, name := range sdb.names {
+		if name == "" {
+			continue
+		}
+		nameId := getNameId(name)
+		if info := sdb.nameRegistrants[nameId]; info != nil {
+			sdb.updateName(name, info)
+		}
 		if commit {
+			delete(sdb.nameRegistrants, nameId)
 			delete(sdb.names, registrantId)
 		}
 	}
+
+	for height, nc := range sdb.nameCleanup {
+		if nc == nil || len(nc) == 0 {
+			sdb.deleteNameCleanup(height)
+		} else {
+			sdb.updateNameCleanup(height, nc)
+		}
+		if commit {
+			delete(sdb.nameCleanup, height)
+		}
+	}
 }
@@ -14,12 +14,17 @@ const (
 	DATA_Header      DataEntryPrefix = 0x01
 	DATA_Transaction DataEntryPrefix = 0x02
 
+	// INDEX
+	IX_AddressTransaction DataEntryPrefix = 0x80
+
 	ST_Prepaid   DataEntryPrefix = 0xc7
 	ST_StateTrie DataEntryPrefix = 0xc8
 
 	//SYSTEM
-	SYS_CurrentBlock DataEntryPrefix = 0x40
-	SYS_Donations    DataEntryPrefix = 0x42
+	SYS_CurrentBlock      DataEntryPrefix = 0x40
+	SYS_Donations         DataEntryPrefix = 0x42
+	SYS_AddressIndexStart DataEntryPrefix = 0x43
+	SYS_SnapshotHeight    DataEntryPrefix = 0x44
 
 	//CONFIG
 	CFG_Version DataEntryPrefix = 0xf0
@@ -43,6 +48,14 @@ func donationKey(height uint32) []byte {
 	return paddingKey(SYS_Donations, heightBuffer)
 }
 
+func addressIndexStartKey() []byte {
+	return paddingKey(SYS_AddressIndexStart, nil)
+}
+
+func snapshotHeightKey() []byte {
+	return paddingKey(SYS_SnapshotHeight, nil)
+}
+
 func currentStateTrie() []byte {
 	return paddingKey(ST_StateTrie, nil)
 }
@@ -65,3 +78,16 @@ func headerKey(blockHash common.Uint256) []byte {
 func transactionKey(txHash common.Uint256) []byte {
 	return paddingKey(DATA_Transaction, txHash.ToArray())
 }
+
+func addressTransactionPrefix(programHash common.Uint160) []byte {
+	return paddingKey(IX_AddressTransaction, programHash.ToArray())
+}
+
+// height and index are big endian so that keys of an address iterate in
+// chain order
+func addressTransactionKey(programHash common.Uint160, height, index uint32) []byte {
+	buffer := make([]byte, 8)
+	binary.BigEndian.PutUint32(buffer[:4], height)
+	binary.BigEndian.PutUint32(buffer[4:], index)
+	return append(addressTransactionPrefix(programHash), buffer...)
+}
@@ -0,0 +1,53 @@
+package db
+
+import (
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/chain/trie"
+	. "github.com/nknorg/nkn/common"
+)
+
+func (cs *ChainStore) getStateProof(key []byte) (*chain.StateProof, error) {
+	header, err := cs.GetHeader(cs.GetCurrentBlockHash())
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := Uint256ParseFromBytes(header.UnsignedHeader.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := trie.New(root, cs.st)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := tr.TryGet(key)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := tr.Prove(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chain.StateProof{
+		Header: header,
+		Key:    key,
+		Value:  value,
+		Proof:  proof,
+	}, nil
+}
+
+func (cs *ChainStore) GetAccountProof(addr Uint160) (*chain.StateProof, error) {
+	return cs.getStateProof(append(AccountPrefix, addr[:]...))
+}
+
+func (cs *ChainStore) GetNameProof(name string) (*chain.StateProof, error) {
+	return cs.getStateProof(append(NamePrefix, getNameId(name)...))
+}
+
+func (cs *ChainStore) GetSubscriptionProof(topic string, bucket uint32, subscriber []byte, identifier string) (*chain.StateProof, error) {
+	return cs.getStateProof(append(PubSubPrefix, getPubSubId(topic, bucket, subscriber, identifier)...))
+}
@@ -0,0 +1,192 @@
+package db
+
+import (
+	"github.com/nknorg/nkn/chain/trie"
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/log"
+)
+
+// max number of stale state trie nodes deleted after each block is saved
+const maxSweepStatesPerBlock = 10000
+
+// statePruner removes state trie nodes that are no longer reachable from the
+// state roots of the most recent blocks. Live nodes are marked and stale ones
+// collected in the background, the deletion itself happens between two blocks
+// so it does not interfere with block persisting, and is spread over as many
+// blocks as needed to bound the time it adds to saving a block.
+type statePruner struct {
+	running    bool
+	lastHeight uint32
+	result     chan *pruneResult
+	sweeping   *pruneResult
+}
+
+type pruneResult struct {
+	height uint32
+	marked map[Uint256]struct{}
+	stale  []Uint256
+	swept  int
+	err    error
+}
+
+func newStatePruner() *statePruner {
+	return &statePruner{
+		result: make(chan *pruneResult, 1),
+	}
+}
+
+// state roots of the current block and as many blocks before it as can be
+// rolled back are always kept
+func statePruningKeepRoots() uint32 {
+	keep := config.Parameters.StatePruningKeepRoots
+	if keep < config.MaxRollbackBlocks+1 {
+		keep = config.MaxRollbackBlocks + 1
+	}
+	if keep < config.Parameters.MaxRollbackDepth+1 {
+		keep = config.Parameters.MaxRollbackDepth + 1
+	}
+	return keep
+}
+
+func (cs *ChainStore) getStateRootByHeight(height uint32) (Uint256, error) {
+	hash, err := cs.GetBlockHash(height)
+	if err != nil {
+		return EmptyUint256, err
+	}
+
+	header, err := cs.GetHeader(hash)
+	if err != nil {
+		return EmptyUint256, err
+	}
+
+	return Uint256ParseFromBytes(header.UnsignedHeader.StateRoot)
+}
+
+// markStateRoots marks nodes reachable from the state roots that should be
+// kept at height. States before the snapshot the db is imported from do not
+// exist.
+func (cs *ChainStore) markStateRoots(height uint32, marked map[Uint256]struct{}) error {
+	start := cs.GetSnapshotHeight()
+	if height+1 > start+statePruningKeepRoots() {
+		start = height + 1 - statePruningKeepRoots()
+	}
+
+	for h := start; h <= height; h++ {
+		root, err := cs.getStateRootByHeight(h)
+		if err != nil {
+			return err
+		}
+		if err := trie.MarkReachable(cs.st, root, marked); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cs *ChainStore) collectStaleStates(height uint32) *pruneResult {
+	res := &pruneResult{
+		height: height,
+		marked: make(map[Uint256]struct{}),
+	}
+
+	if res.err = cs.markStateRoots(height, res.marked); res.err != nil {
+		return res
+	}
+
+	prefix := trie.NodeKeyPrefix()
+	iter := cs.st.NewIterator(prefix)
+	defer iter.Release()
+
+	for iter.Next() {
+		hash, err := Uint256ParseFromBytes(iter.Key()[len(prefix):])
+		if err != nil {
+			continue
+		}
+		if _, ok := res.marked[hash]; !ok {
+			res.stale = append(res.stale, hash)
+		}
+	}
+
+	return res
+}
+
+// sweepStates deletes up to max of the stale nodes collected in res and
+// removes them from res. Nodes written since res was collected may have
+// recreated some of them, so the roots up to height are marked again first.
+func (cs *ChainStore) sweepStates(res *pruneResult, height uint32, max int) (int, error) {
+	if err := cs.markStateRoots(height, res.marked); err != nil {
+		return 0, err
+	}
+
+	n := len(res.stale)
+	if n > max {
+		n = max
+	}
+
+	if err := cs.st.NewBatch(); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, hash := range res.stale[:n] {
+		if _, ok := res.marked[hash]; ok {
+			continue
+		}
+		if err := cs.st.BatchDelete(trie.NodeKey(hash)); err != nil {
+			return 0, err
+		}
+		count++
+	}
+
+	if err := cs.st.BatchCommit(); err != nil {
+		return 0, err
+	}
+
+	res.stale = res.stale[n:]
+	res.swept += count
+
+	return count, nil
+}
+
+// pruneStates is called after each block is saved. It deletes part of the
+// stale nodes of a finished pruning round and starts a new round every
+// StatePruningKeepRoots blocks once the previous one is swept.
+func (cs *ChainStore) pruneStates(height uint32) {
+	if !config.Parameters.StatePruning {
+		return
+	}
+
+	p := cs.pruner
+	if p.sweeping == nil {
+		select {
+		case res := <-p.result:
+			p.running = false
+			if res.err != nil {
+				log.Errorf("Collect stale states at height %d error: %v", res.height, res.err)
+				break
+			}
+			p.sweeping = res
+		default:
+		}
+	}
+
+	if p.sweeping != nil {
+		if _, err := cs.sweepStates(p.sweeping, height, maxSweepStatesPerBlock); err != nil {
+			log.Errorf("Prune stale states error: %v", err)
+			p.sweeping = nil
+		} else if len(p.sweeping.stale) == 0 {
+			log.Infof("Pruned %d stale state trie nodes", p.sweeping.swept)
+			p.sweeping = nil
+		}
+	}
+
+	if !p.running && p.sweeping == nil && height >= p.lastHeight+statePruningKeepRoots() {
+		p.running = true
+		p.lastHeight = height
+		go func() {
+			p.result <- cs.collectStaleStates(height)
+		}()
+	}
+}
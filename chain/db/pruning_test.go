@@ -0,0 +1,114 @@
+package db
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/chain/trie"
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/util/config"
+)
+
+func TestPruneStates(t *testing.T) {
+	keepRoots, maxRollbackDepth := config.Parameters.StatePruningKeepRoots, config.Parameters.MaxRollbackDepth
+	defer func() {
+		config.Parameters.StatePruningKeepRoots, config.Parameters.MaxRollbackDepth = keepRoots, maxRollbackDepth
+	}()
+	config.Parameters.StatePruningKeepRoots = 2
+	config.Parameters.MaxRollbackDepth = 1
+
+	st, err := OpenStore(MemoryBackend, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs := &ChainStore{st: st, pruner: newStatePruner()}
+
+	tr, err := trie.New(EmptyUint256, st)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the state at a height has key-0 to key-99 set to value-<values[height]>
+	values := []int{0, 1, 2, 3, 4, 0}
+	roots := make([]Uint256, len(values))
+	addBlock := func(height int) {
+		for i := 0; i < 100; i++ {
+			tr.Update([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", values[height])))
+		}
+		st.NewBatch()
+		if roots[height], err = tr.CommitTo(st); err != nil {
+			t.Fatal(err)
+		}
+		b := &block.Block{
+			Header: &block.Header{
+				Header: &pb.Header{
+					UnsignedHeader: &pb.UnsignedHeader{
+						Height:    uint32(height),
+						StateRoot: roots[height].ToArray(),
+					},
+				},
+			},
+		}
+		if err := cs.batchPutBlock(b); err != nil {
+			t.Fatal(err)
+		}
+		if err := st.BatchCommit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	last := len(values) - 1
+	for height := 0; height < last; height++ {
+		addBlock(height)
+	}
+
+	res := cs.collectStaleStates(uint32(last - 1))
+	if res.err != nil {
+		t.Fatal(res.err)
+	}
+	if len(res.stale) == 0 {
+		t.Fatal("no stale states collected")
+	}
+
+	// the last block recreates the state of the first one after it is collected
+	addBlock(last)
+
+	for len(res.stale) > 0 {
+		count, err := cs.sweepStates(res, uint32(last), 7)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count > 7 {
+			t.Fatalf("swept %d states, more than max 7", count)
+		}
+	}
+	if res.swept == 0 {
+		t.Fatal("no stale states swept")
+	}
+
+	for _, height := range []int{last - 1, last} {
+		tr, err := trie.New(roots[height], st)
+		if err != nil {
+			t.Fatalf("open kept root at height %d: %v", height, err)
+		}
+		for i := 0; i < 100; i++ {
+			key := []byte(fmt.Sprintf("key-%d", i))
+			value, err := tr.TryGet(key)
+			if err != nil {
+				t.Fatalf("get %s at height %d: %v", key, height, err)
+			}
+			if string(value) != fmt.Sprintf("value-%d", values[height]) {
+				t.Fatalf("get %s at height %d: got %s", key, height, value)
+			}
+		}
+	}
+
+	// roots kept when collected are only pruned in the next round
+	for height := 1; height < last-2; height++ {
+		if ok, _ := st.Has(trie.NodeKey(roots[height])); ok {
+			t.Fatalf("stale root at height %d is not pruned", height)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"fmt"
 
 	"github.com/nknorg/nkn/block"
 	"github.com/nknorg/nkn/common"
@@ -23,6 +24,10 @@ func (cs *ChainStore) Rollback(b *block.Block) error {
 		return errors.New("the genesis block need not be rolled back.")
 	}
 
+	if b.Header.UnsignedHeader.Height <= cs.GetSnapshotHeight() {
+		return fmt.Errorf("block at height %d can not be rolled back, the db is imported from snapshot at height %d", b.Header.UnsignedHeader.Height, cs.GetSnapshotHeight())
+	}
+
 	if err := cs.rollbackHeader(b); err != nil {
 		return err
 	}
@@ -31,6 +36,10 @@ func (cs *ChainStore) Rollback(b *block.Block) error {
 		return err
 	}
 
+	if err := cs.rollbackAddressIndex(b); err != nil {
+		return err
+	}
+
 	if err := cs.rollbackBlockHash(b); err != nil {
 		return err
 	}
@@ -55,6 +64,16 @@ func (cs *ChainStore) Rollback(b *block.Block) error {
 		return err
 	}
 
+	prevHash, err := common.Uint256ParseFromBytes(b.Header.UnsignedHeader.PrevBlockHash)
+	if err != nil {
+		return err
+	}
+
+	cs.mu.Lock()
+	cs.currentBlockHeight = b.Header.UnsignedHeader.Height - 1
+	cs.currentBlockHash = prevHash
+	cs.mu.Unlock()
+
 	return nil
 }
 
@@ -124,6 +143,20 @@ func (cs *ChainStore) rollbackStates(b *block.Block) error {
 
 func (cs *ChainStore) rollbackHeaderCache(b *block.Block) error {
 	cs.headerCache.RollbackHeader(b.Header)
+
+	// only the last few headers are cached, make sure the new tip is
+	prevHash, err := common.Uint256ParseFromBytes(b.Header.UnsignedHeader.PrevBlockHash)
+	if err != nil {
+		return err
+	}
+
+	prevHead, err := cs.GetHeader(prevHash)
+	if err != nil {
+		return err
+	}
+
+	cs.headerCache.AddHeaderToCache(prevHead)
+
 	return nil
 }
 
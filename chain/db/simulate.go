@@ -0,0 +1,174 @@
+package db
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/nknorg/nkn/chain"
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/transaction"
+)
+
+// ComputeTransactionChanges applies txn with spendTransaction to a throwaway
+// StateDB opened at the current state root, as if txn was included in a
+// block at height, and fills sim with the balances, nonce, names and
+// subscriptions it changed. Pending txns are not applied first, and the ledger
+// state is not changed.
+func (cs *ChainStore) ComputeTransactionChanges(txn *transaction.Transaction, height uint32, sim *chain.TxnSimulation) error {
+	root, err := cs.GetCurrentBlockStateRoot()
+	if err != nil {
+		return err
+	}
+	before, err := NewStateDB(root, NewTrieStore(cs.GetDatabase()))
+	if err != nil {
+		return err
+	}
+	after, err := NewStateDB(root, NewTrieStore(cs.GetDatabase()))
+	if err != nil {
+		return err
+	}
+
+	if err := cs.spendTransaction(after, txn, 0, false, height); err != nil {
+		return err
+	}
+
+	if after.GetNonce(sim.Sender) > before.GetNonce(sim.Sender) {
+		sim.NewNonce = txn.UnsignedTx.Nonce + 1
+	}
+
+	sim.BalanceChanges = diffBalances(before, after)
+
+	sim.NameChanges, err = diffNames(before, after)
+	if err != nil {
+		return err
+	}
+
+	sim.SubscriptionChanges, err = diffSubscriptions(before, after, txn)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// diffBalances returns the balance changes of the accounts touched in after,
+// sorted by address and asset.
+func diffBalances(before, after *StateDB) []*chain.BalanceChange {
+	changes := make([]*chain.BalanceChange, 0)
+	after.accounts.Range(func(key, value interface{}) bool {
+		addr := key.(Uint160)
+		for assetID, b := range value.(*account).balances {
+			if amount := b.amount - before.GetBalance(assetID, addr); amount != 0 {
+				changes = append(changes, &chain.BalanceChange{Address: addr, AssetID: assetID, Amount: amount})
+			}
+		}
+		return true
+	})
+
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.Address != b.Address {
+			return a.Address.CompareTo(b.Address) < 0
+		}
+		return a.AssetID.CompareTo(b.AssetID) < 0
+	})
+
+	return changes
+}
+
+// diffNames returns the names registered, renewed, transferred or deleted in
+// after, sorted by name.
+func diffNames(before, after *StateDB) ([]*chain.NameChange, error) {
+	changes := make([]*chain.NameChange, 0)
+	for registrantId, name := range after.names {
+		registrant := []byte(registrantId)
+		if name == "" {
+			// the name of registrant was deleted or transferred away
+			oldName, err := before.getName(registrant)
+			if err != nil {
+				return nil, err
+			}
+			if oldName == "" {
+				continue
+			}
+			if info := after.nameRegistrants[getNameId(oldName)]; info == nil {
+				changes = append(changes, &chain.NameChange{
+					Action:     chain.NameChangeDelete,
+					Name:       oldName,
+					Registrant: registrant,
+				})
+			}
+			continue
+		}
+
+		info := after.nameRegistrants[getNameId(name)]
+		if info == nil || !bytes.Equal(info.registrant, registrant) {
+			continue
+		}
+		old, err := before.getNameInfo(name)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case old == nil || bytes.Equal(old.registrant, info.registrant) && old.expiresAt != info.expiresAt:
+			changes = append(changes, &chain.NameChange{
+				Action:     chain.NameChangeRegister,
+				Name:       name,
+				Registrant: info.registrant,
+				Expiration: info.expiresAt,
+			})
+		case !bytes.Equal(old.registrant, info.registrant):
+			changes = append(changes, &chain.NameChange{
+				Action:     chain.NameChangeTransfer,
+				Name:       name,
+				Registrant: old.registrant,
+				Recipient:  info.registrant,
+			})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+
+	return changes, nil
+}
+
+// diffSubscriptions returns the subscription of txn if it was created or
+// changed in after. Topic and bucket are taken from the payload, since the
+// state only keeps their hash.
+func diffSubscriptions(before, after *StateDB, txn *transaction.Transaction) ([]*chain.SubscriptionChange, error) {
+	changes := make([]*chain.SubscriptionChange, 0)
+	if txn.UnsignedTx.Payload.Type != pb.SUBSCRIBE_TYPE {
+		return changes, nil
+	}
+
+	pl, err := transaction.Unpack(txn.UnsignedTx.Payload)
+	if err != nil {
+		return nil, err
+	}
+	subscribe := pl.(*pb.Subscribe)
+
+	id := string(getPubSubId(subscribe.Topic, subscribe.Bucket, subscribe.Subscriber, subscribe.Identifier))
+	ps, ok := after.pubSub[id]
+	if !ok {
+		return changes, nil
+	}
+	old, err := before.getPubSub([]byte(id))
+	if err != nil {
+		return nil, err
+	}
+	if ps.meta != old.meta || ps.expiresAt != old.expiresAt {
+		changes = append(changes, &chain.SubscriptionChange{
+			Topic:      subscribe.Topic,
+			Bucket:     subscribe.Bucket,
+			Subscriber: ps.subscriber,
+			Identifier: ps.identifier,
+			Meta:       ps.meta,
+			Expiration: ps.expiresAt,
+		})
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,361 @@
+package db
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/chain/trie"
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/common/serialization"
+	"github.com/nknorg/nkn/crypto"
+	"github.com/nknorg/nkn/signature"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/log"
+)
+
+const (
+	snapshotMagic   = "NKNSNAPSHOT"
+	snapshotVersion = 1
+
+	// number of blocks up to the snapshot height included in a snapshot, so
+	// that recent blocks looked up when processing new blocks are available
+	// after import
+	snapshotRecentBlocks = 32
+)
+
+// snapshotNodes holds the state trie nodes read from a snapshot until they
+// are verified. Unlike trie.MemDatabase, missing nodes are reported as errors.
+type snapshotNodes map[string][]byte
+
+func (sn snapshotNodes) Get(key []byte) ([]byte, error) {
+	if enc, ok := sn[string(key)]; ok {
+		return enc, nil
+	}
+	return nil, fmt.Errorf("state trie node %x not found in snapshot", key)
+}
+
+func (sn snapshotNodes) Has(key []byte) (bool, error) {
+	_, ok := sn[string(key)]
+	return ok, nil
+}
+
+func (sn snapshotNodes) BatchPut(key, value []byte) error {
+	sn[string(key)] = value
+	return nil
+}
+
+// GetSnapshotHeight returns the height of the snapshot the db was seeded with,
+// or 0 if it was not.
+func (cs *ChainStore) GetSnapshotHeight() uint32 {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+	return cs.snapshotHeight
+}
+
+// ExportSnapshot writes the state trie at height, together with the genesis
+// block, the most recent blocks up to height and the current donation, to w.
+// The snapshot ends with a sha256 checksum of its content.
+func (cs *ChainStore) ExportSnapshot(w io.Writer, height uint32) error {
+	if height > cs.GetHeight() {
+		return fmt.Errorf("snapshot height %d is higher than current height %d", height, cs.GetHeight())
+	}
+
+	checksum := sha256.New()
+	mw := io.MultiWriter(w, checksum)
+
+	if err := serialization.WriteVarString(mw, snapshotMagic); err != nil {
+		return err
+	}
+	if err := serialization.WriteUint32(mw, snapshotVersion); err != nil {
+		return err
+	}
+	if err := serialization.WriteByte(mw, config.DBVersion); err != nil {
+		return err
+	}
+
+	heights := []uint32{0}
+	start := uint32(1)
+	if height+1 > snapshotRecentBlocks {
+		start = height + 1 - snapshotRecentBlocks
+	}
+	for h := start; h <= height; h++ {
+		heights = append(heights, h)
+	}
+
+	if err := serialization.WriteUint32(mw, uint32(len(heights))); err != nil {
+		return err
+	}
+	var header *block.Header
+	for _, h := range heights {
+		b, err := cs.GetBlockByHeight(h)
+		if err != nil {
+			return fmt.Errorf("get block at height %d error: %v", h, err)
+		}
+		data, err := b.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := serialization.WriteVarBytes(mw, data); err != nil {
+			return err
+		}
+		header = b.Header
+	}
+
+	donationHeight := height / uint32(config.RewardAdjustInterval) * uint32(config.RewardAdjustInterval)
+	donation, err := cs.st.Get(donationKey(donationHeight))
+	if err != nil {
+		return fmt.Errorf("get donation at height %d error: %v", donationHeight, err)
+	}
+	if err := serialization.WriteUint32(mw, donationHeight); err != nil {
+		return err
+	}
+	if err := serialization.WriteVarBytes(mw, donation); err != nil {
+		return err
+	}
+
+	root, err := Uint256ParseFromBytes(header.UnsignedHeader.StateRoot)
+	if err != nil {
+		return err
+	}
+	marked := make(map[Uint256]struct{})
+	if err := trie.MarkReachable(cs.st, root, marked); err != nil {
+		return fmt.Errorf("state trie at height %d is not available: %v", height, err)
+	}
+	hashes := make([]Uint256, 0, len(marked))
+	for hash := range marked {
+		hashes = append(hashes, hash)
+	}
+	sort.Slice(hashes, func(i, j int) bool {
+		return bytes.Compare(hashes[i][:], hashes[j][:]) < 0
+	})
+
+	if err := serialization.WriteUint64(mw, uint64(len(hashes))); err != nil {
+		return err
+	}
+	for _, hash := range hashes {
+		enc, err := cs.st.Get(trie.NodeKey(hash))
+		if err != nil {
+			return err
+		}
+		if err := serialization.WriteVarBytes(mw, enc); err != nil {
+			return err
+		}
+	}
+
+	_, err = w.Write(checksum.Sum(nil))
+	return err
+}
+
+// ImportSnapshot seeds a chain db that contains only the genesis block with a
+// snapshot written by ExportSnapshot, and returns the snapshot height. The
+// snapshot block should have trustedHash, obtained from a trusted source, as
+// the content of a snapshot is not trusted otherwise. Blocks in the snapshot
+// should be signed by their signer and chained by previous block hash, and the
+// state trie is verified against the state root in the header of the snapshot
+// block before anything is written. Only the state at the snapshot height is
+// imported, so blocks at and below it can not be rolled back.
+func (cs *ChainStore) ImportSnapshot(r io.Reader, trustedHash Uint256) (uint32, error) {
+	if cs.GetHeight() != 0 {
+		return 0, errors.New("snapshot can only be imported into a new chain db")
+	}
+
+	checksum := sha256.New()
+	tr := io.TeeReader(r, checksum)
+
+	magic, err := serialization.ReadVarString(tr)
+	if err != nil {
+		return 0, err
+	}
+	if magic != snapshotMagic {
+		return 0, errors.New("invalid snapshot file")
+	}
+	version, err := serialization.ReadUint32(tr)
+	if err != nil {
+		return 0, err
+	}
+	if version != snapshotVersion {
+		return 0, fmt.Errorf("unsupported snapshot version %d", version)
+	}
+	dbVersion, err := serialization.ReadByte(tr)
+	if err != nil {
+		return 0, err
+	}
+	if dbVersion != config.DBVersion {
+		return 0, fmt.Errorf("snapshot database version %d is different from %d", dbVersion, config.DBVersion)
+	}
+
+	count, err := serialization.ReadUint32(tr)
+	if err != nil {
+		return 0, err
+	}
+	if count == 0 {
+		return 0, errors.New("no block in snapshot")
+	}
+	blocks := make([]*block.Block, 0, count)
+	for i := uint32(0); i < count; i++ {
+		data, err := serialization.ReadVarBytes(tr)
+		if err != nil {
+			return 0, err
+		}
+		b := &block.Block{}
+		if err := b.Unmarshal(data); err != nil {
+			return 0, err
+		}
+		if i == 0 {
+			genesisHash, err := cs.GetBlockHash(0)
+			if err != nil {
+				return 0, err
+			}
+			if b.Hash() != genesisHash {
+				return 0, errors.New("snapshot genesis block is different from local genesis block")
+			}
+		} else if err := verifySnapshotBlock(b); err != nil {
+			return 0, err
+		}
+		if i > 1 {
+			prev := blocks[i-1]
+			prevHash := prev.Hash()
+			if b.Header.UnsignedHeader.Height != prev.Header.UnsignedHeader.Height+1 || !bytes.Equal(b.Header.UnsignedHeader.PrevBlockHash, prevHash.ToArray()) {
+				return 0, fmt.Errorf("snapshot block at height %d does not follow previous block", b.Header.UnsignedHeader.Height)
+			}
+		}
+		blocks = append(blocks, b)
+	}
+	last := blocks[len(blocks)-1]
+	height := last.Header.UnsignedHeader.Height
+	if lastHash := last.Hash(); lastHash != trustedHash {
+		return 0, fmt.Errorf("snapshot block hash %s at height %d is different from trusted hash %s", lastHash.ToHexString(), height, trustedHash.ToHexString())
+	}
+
+	donationHeight, err := serialization.ReadUint32(tr)
+	if err != nil {
+		return 0, err
+	}
+	donation, err := serialization.ReadVarBytes(tr)
+	if err != nil {
+		return 0, err
+	}
+	if donationHeight != height/uint32(config.RewardAdjustInterval)*uint32(config.RewardAdjustInterval) {
+		return 0, errors.New("invalid snapshot donation height")
+	}
+
+	numNodes, err := serialization.ReadUint64(tr)
+	if err != nil {
+		return 0, err
+	}
+	nodes := make(snapshotNodes)
+	for i := uint64(0); i < numNodes; i++ {
+		enc, err := serialization.ReadVarBytes(tr)
+		if err != nil {
+			return 0, err
+		}
+		nodes[string(trie.NodeKey(trie.NodeHash(enc)))] = enc
+	}
+
+	expected, err := serialization.ReadBytes(r, sha256.Size)
+	if err != nil {
+		return 0, err
+	}
+	if !bytes.Equal(expected, checksum.Sum(nil)) {
+		return 0, errors.New("snapshot checksum mismatch")
+	}
+
+	root, err := Uint256ParseFromBytes(last.Header.UnsignedHeader.StateRoot)
+	if err != nil {
+		return 0, err
+	}
+	marked := make(map[Uint256]struct{})
+	if err := trie.MarkReachable(nodes, root, marked); err != nil {
+		return 0, fmt.Errorf("verify state trie against state root %s error: %v", root.ToHexString(), err)
+	}
+
+	if err := cs.st.NewBatch(); err != nil {
+		return 0, err
+	}
+	for _, b := range blocks[1:] {
+		if err := cs.batchPutBlock(b); err != nil {
+			return 0, err
+		}
+	}
+	for hash := range marked {
+		key := trie.NodeKey(hash)
+		if err := cs.st.BatchPut(key, nodes[string(key)]); err != nil {
+			return 0, err
+		}
+	}
+	if err := cs.st.BatchPut(donationKey(donationHeight), donation); err != nil {
+		return 0, err
+	}
+	if err := cs.st.BatchPut(currentStateTrie(), root.ToArray()); err != nil {
+		return 0, err
+	}
+	if err := cs.batchPutCurrentBlockHash(last.Hash(), height); err != nil {
+		return 0, err
+	}
+	snapshotHeight := make([]byte, 4)
+	binary.LittleEndian.PutUint32(snapshotHeight, height)
+	if err := cs.st.BatchPut(snapshotHeightKey(), snapshotHeight); err != nil {
+		return 0, err
+	}
+	if err := cs.st.BatchCommit(); err != nil {
+		return 0, err
+	}
+
+	cs.mu.Lock()
+	cs.currentBlockHeight = height
+	cs.currentBlockHash = last.Hash()
+	cs.snapshotHeight = height
+	cs.mu.Unlock()
+
+	cs.headerCache.AddHeaderToCache(last.Header)
+
+	// blocks in the snapshot are not indexed by address
+	if config.Parameters.TxIndexByAddress {
+		if err := cs.setAddressIndexStart(height + 1); err != nil {
+			return 0, err
+		}
+	}
+
+	cs.States, err = NewStateDB(root, NewTrieStore(cs.GetDatabase()))
+	if err != nil {
+		return 0, err
+	}
+
+	log.Infof("Imported snapshot at height %d with %d state trie nodes", height, len(marked))
+
+	return height, nil
+}
+
+// verifySnapshotBlock checks that the txns of b match the txn root in its
+// header, and that the header is signed by its signer.
+func verifySnapshotBlock(b *block.Block) error {
+	pubKey, err := crypto.DecodePoint(b.Header.UnsignedHeader.SignerPk)
+	if err != nil {
+		return fmt.Errorf("decode signer public key of block at height %d error: %v", b.Header.UnsignedHeader.Height, err)
+	}
+	err = crypto.Verify(*pubKey, signature.GetHashForSigning(b.Header), b.Header.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid header signature of block at height %d: %v", b.Header.UnsignedHeader.Height, err)
+	}
+
+	txnsHash := make([]Uint256, 0, len(b.Transactions))
+	for _, txn := range b.Transactions {
+		txnsHash = append(txnsHash, txn.Hash())
+	}
+
+	txnsRoot, err := crypto.ComputeRoot(txnsHash)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(txnsRoot.ToArray(), b.Header.UnsignedHeader.TransactionsRoot) {
+		return fmt.Errorf("computed txn root %x is different from txn root in header %x of block at height %d", txnsRoot.ToArray(), b.Header.UnsignedHeader.TransactionsRoot, b.Header.UnsignedHeader.Height)
+	}
+
+	return nil
+}
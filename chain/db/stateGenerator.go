@@ -45,9 +45,81 @@ func (cs *ChainStore) spendTransaction(states *StateDB, txn *transaction.Transac
 		}
 	case pb.TRANSFER_ASSET_TYPE:
 		transfer := pl.(*pb.TransferAsset)
-		states.UpdateBalance(BytesToUint160(transfer.Sender), config.NKNAssetID, Fixed64(transfer.Amount)+Fixed64(txn.UnsignedTx.Fee), Subtraction)
+		assetID, err := chain.GetTransferAssetID(transfer)
+		if err != nil {
+			return err
+		}
+
+		if assetID == config.NKNAssetID {
+			states.UpdateBalance(BytesToUint160(transfer.Sender), config.NKNAssetID, Fixed64(transfer.Amount)+Fixed64(txn.UnsignedTx.Fee), Subtraction)
+		} else {
+			asset, err := states.GetAsset(assetID)
+			if err != nil {
+				return err
+			}
+			if asset == nil {
+				return fmt.Errorf("asset %v does not exist", assetID.ToHexString())
+			}
+
+			if err := states.UpdateBalance(BytesToUint160(transfer.Sender), config.NKNAssetID, Fixed64(txn.UnsignedTx.Fee), Subtraction); err != nil {
+				return err
+			}
+			if err := states.UpdateBalance(BytesToUint160(transfer.Sender), assetID, Fixed64(transfer.Amount), Subtraction); err != nil {
+				return err
+			}
+		}
 		states.IncrNonce(BytesToUint160(transfer.Sender))
-		states.UpdateBalance(BytesToUint160(transfer.Recipient), config.NKNAssetID, Fixed64(transfer.Amount), Addition)
+		states.UpdateBalance(BytesToUint160(transfer.Recipient), assetID, Fixed64(transfer.Amount), Addition)
+
+	case pb.BATCH_TRANSFER_ASSET_TYPE:
+		batch := pl.(*pb.BatchTransferAsset)
+		sender := BytesToUint160(batch.Sender)
+		fee := Fixed64(txn.UnsignedTx.Fee)
+		amounts, err := chain.GetBatchTransferAmounts(batch)
+		if err != nil {
+			return err
+		}
+
+		// check all debits before changing any balance, so that the batch is
+		// applied entirely or not at all
+		if states.GetBalance(config.NKNAssetID, sender) < fee {
+			return fmt.Errorf("not sufficient funds for batch transfer fee %v", fee.String())
+		}
+		for assetID, amount := range amounts {
+			balance := states.GetBalance(assetID, sender)
+			if assetID == config.NKNAssetID {
+				balance -= fee
+			} else {
+				asset, err := states.GetAsset(assetID)
+				if err != nil {
+					return err
+				}
+				if asset == nil {
+					return fmt.Errorf("asset %v does not exist", assetID.ToHexString())
+				}
+			}
+			if balance < amount {
+				return fmt.Errorf("not sufficient funds of asset %v for batch transfer", assetID.ToHexString())
+			}
+		}
+
+		if err := states.UpdateBalance(sender, config.NKNAssetID, fee, Subtraction); err != nil {
+			return err
+		}
+		for assetID, amount := range amounts {
+			if err := states.UpdateBalance(sender, assetID, amount, Subtraction); err != nil {
+				return err
+			}
+		}
+		states.IncrNonce(sender)
+
+		for _, output := range batch.Outputs {
+			assetID, err := chain.GetTransferOutputAssetID(output)
+			if err != nil {
+				return err
+			}
+			states.UpdateBalance(BytesToUint160(output.Recipient), assetID, Fixed64(output.Amount), Addition)
+		}
 
 	case pb.REGISTER_NAME_TYPE:
 		pg, err := txn.GetProgramHashes()
@@ -61,7 +133,24 @@ func (cs *ChainStore) spendTransaction(states *StateDB, txn *transaction.Transac
 		states.IncrNonce(pg[0])
 
 		registerNamePayload := pl.(*pb.RegisterName)
-		states.setName(registerNamePayload.Registrant, registerNamePayload.Name)
+		if err := states.setName(registerNamePayload.Registrant, registerNamePayload.Name, height+config.NameRegistrationDuration); err != nil {
+			return err
+		}
+	case pb.TRANSFER_NAME_TYPE:
+		pg, err := txn.GetProgramHashes()
+		if err != nil {
+			return err
+		}
+
+		if err := states.UpdateBalance(pg[0], config.NKNAssetID, Fixed64(txn.UnsignedTx.Fee), Subtraction); err != nil {
+			return err
+		}
+		states.IncrNonce(pg[0])
+
+		transferNamePayload := pl.(*pb.TransferName)
+		if err := states.transferName(transferNamePayload.Registrant, transferNamePayload.Recipient, transferNamePayload.Name); err != nil {
+			return err
+		}
 	case pb.DELETE_NAME_TYPE:
 		pg, err := txn.GetProgramHashes()
 		if err != nil {
@@ -74,7 +163,9 @@ func (cs *ChainStore) spendTransaction(states *StateDB, txn *transaction.Transac
 		states.IncrNonce(pg[0])
 
 		deleteNamePayload := pl.(*pb.DeleteName)
-		states.deleteNameForRegistrant(deleteNamePayload.Registrant, deleteNamePayload.Name)
+		if err := states.deleteNameForRegistrant(deleteNamePayload.Registrant, deleteNamePayload.Name); err != nil {
+			return err
+		}
 	case pb.SUBSCRIBE_TYPE:
 		pg, err := txn.GetProgramHashes()
 		if err != nil {
@@ -272,6 +363,10 @@ func (cs *ChainStore) generateStateRoot(b *block.Block, genesisBlockInitialized,
 		if err = states.CleanupPubSub(b.Header.UnsignedHeader.Height); err != nil {
 			return nil, EmptyUint256, err
 		}
+
+		if err = states.CleanupNames(b.Header.UnsignedHeader.Height); err != nil {
+			return nil, EmptyUint256, err
+		}
 	}
 
 	var root Uint256
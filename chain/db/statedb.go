@@ -15,6 +15,7 @@ var (
 	PubSubPrefix         = []byte{0x05}
 	PubSubCleanupPrefix  = []byte{0x06}
 	IssueAssetPrefix     = []byte{0x07}
+	NameCleanupPrefix    = []byte{0x08}
 )
 
 type StateDB struct {
@@ -24,7 +25,8 @@ type StateDB struct {
 	nanoPay         map[string]*nanoPay
 	nanoPayCleanup  map[uint32]map[string]struct{}
 	names           map[string]string
-	nameRegistrants map[string][]byte
+	nameRegistrants map[string]*nameInfo
+	nameCleanup     map[uint32]map[string]struct{}
 	pubSub          map[string]*pubSub
 	pubSubCleanup   map[uint32]map[string]struct{}
 	assets          map[common.Uint256]*Asset
@@ -41,7 +43,8 @@ func NewStateDB(root common.Uint256, db *cachingDB) (*StateDB, error) {
 		nanoPay:         make(map[string]*nanoPay, 0),
 		nanoPayCleanup:  make(map[uint32]map[string]struct{}, 0),
 		names:           make(map[string]string, 0),
-		nameRegistrants: make(map[string][]byte, 0),
+		nameRegistrants: make(map[string]*nameInfo, 0),
+		nameCleanup:     make(map[uint32]map[string]struct{}, 0),
 		pubSub:          make(map[string]*pubSub, 0),
 		pubSubCleanup:   make(map[uint32]map[string]struct{}, 0),
 		assets:          make(map[common.Uint256]*Asset, 0),
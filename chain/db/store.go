@@ -29,10 +29,24 @@ type ChainStore struct {
 
 	currentBlockHash   Uint256
 	currentBlockHeight uint32
+
+	addressIndexStart uint32
+	snapshotHeight    uint32 // blocks and states before it are not in db
+	pruner            *statePruner
 }
 
 func NewLedgerStore() (*ChainStore, error) {
-	st, err := NewLevelDBStore(config.Parameters.ChainDBPath)
+	return newLedgerStore(false)
+}
+
+// NewReadOnlyLedgerStore opens the configured chain db without modifying it,
+// for inspection tools that may run while nknd is using the same db.
+func NewReadOnlyLedgerStore() (*ChainStore, error) {
+	return newLedgerStore(true)
+}
+
+func newLedgerStore(readOnly bool) (*ChainStore, error) {
+	st, err := OpenStore(config.Parameters.ChainDBBackend, config.Parameters.ChainDBPath, readOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -43,6 +57,7 @@ func NewLedgerStore() (*ChainStore, error) {
 		headerCache:        NewHeaderCache(),
 		currentBlockHeight: 0,
 		currentBlockHash:   EmptyUint256,
+		pruner:             newStatePruner(),
 	}
 
 	return chain, nil
@@ -96,6 +111,14 @@ func (cs *ChainStore) InitLedgerStoreWithGenesisBlock(genesisBlock *block.Block)
 			return 0, err
 		}
 
+		if data, err := cs.st.Get(snapshotHeightKey()); err == nil && len(data) == 4 {
+			cs.snapshotHeight = binary.LittleEndian.Uint32(data)
+		}
+
+		if err := cs.initAddressIndex(); err != nil {
+			return 0, err
+		}
+
 		return cs.currentBlockHeight, nil
 
 	} else {
@@ -118,6 +141,12 @@ func (cs *ChainStore) InitLedgerStoreWithGenesisBlock(genesisBlock *block.Block)
 			return 0, err
 		}
 
+		if config.Parameters.TxIndexByAddress {
+			if err := cs.setAddressIndexStart(0); err != nil {
+				return 0, err
+			}
+		}
+
 		cs.headerCache.AddHeaderToCache(genesisBlock.Header)
 		cs.currentBlockHash = genesisBlock.Hash()
 		cs.currentBlockHeight = 0
@@ -190,6 +219,15 @@ func (cs *ChainStore) GetTransaction(hash Uint256) (*transaction.Transaction, er
 	return t, nil
 }
 
+func (cs *ChainStore) GetTransactionHeight(hash Uint256) (uint32, error) {
+	_, height, err := cs.getTx(hash)
+	if err != nil {
+		return 0, err
+	}
+
+	return height, nil
+}
+
 func (cs *ChainStore) getTx(hash Uint256) (*transaction.Transaction, uint32, error) {
 	value, err := cs.st.Get(transactionKey(hash))
 	if err != nil {
@@ -248,9 +286,7 @@ func (cs *ChainStore) IsBlockInStore(hash Uint256) bool {
 	return true
 }
 
-func (cs *ChainStore) persist(b *block.Block) error {
-	cs.st.NewBatch()
-
+func (cs *ChainStore) batchPutBlock(b *block.Block) error {
 	headerHash := b.Hash()
 
 	//batch put header
@@ -281,13 +317,34 @@ func (cs *ChainStore) persist(b *block.Block) error {
 		if err := cs.st.BatchPut(transactionKey(txn.Hash()), buffer); err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
+
+func (cs *ChainStore) batchPutCurrentBlockHash(hash Uint256, height uint32) error {
+	buffer := bytes.NewBuffer(nil)
+	hash.Serialize(buffer)
+	serialization.WriteUint32(buffer, height)
+	return cs.st.BatchPut(currentBlockHashKey(), buffer.Bytes())
+}
+
+func (cs *ChainStore) persist(b *block.Block) error {
+	cs.st.NewBatch()
 
+	if err := cs.batchPutBlock(b); err != nil {
+		return err
+	}
+
+	for _, txn := range b.Transactions {
 		switch txn.UnsignedTx.Payload.Type {
 		case pb.COINBASE_TYPE:
 		case pb.SIG_CHAIN_TXN_TYPE:
 		case pb.TRANSFER_ASSET_TYPE:
+		case pb.BATCH_TRANSFER_ASSET_TYPE:
 		case pb.ISSUE_ASSET_TYPE:
 		case pb.REGISTER_NAME_TYPE:
+		case pb.TRANSFER_NAME_TYPE:
 		case pb.DELETE_NAME_TYPE:
 		case pb.SUBSCRIBE_TYPE:
 		case pb.GENERATE_ID_TYPE:
@@ -297,6 +354,10 @@ func (cs *ChainStore) persist(b *block.Block) error {
 		}
 	}
 
+	if err := cs.persistAddressIndex(b); err != nil {
+		return err
+	}
+
 	//StateRoot
 	states, root, err := cs.generateStateRoot(b, b.Header.UnsignedHeader.Height != 0, true)
 	if err != nil {
@@ -335,9 +396,7 @@ func (cs *ChainStore) persist(b *block.Block) error {
 	}
 
 	//batch put currentblockhash
-	serialization.WriteUint32(headerHashBuffer, b.Header.UnsignedHeader.Height)
-	err = cs.st.BatchPut(currentBlockHashKey(), headerHashBuffer.Bytes())
-	if err != nil {
+	if err := cs.batchPutCurrentBlockHash(b.Hash(), b.Header.UnsignedHeader.Height); err != nil {
 		return err
 	}
 
@@ -367,6 +426,8 @@ func (cs *ChainStore) SaveBlock(b *block.Block, fastAdd bool) error {
 	}
 	cs.headerCache.AddHeaderToCache(b.Header)
 
+	cs.pruneStates(cs.currentBlockHeight)
+
 	return nil
 }
 
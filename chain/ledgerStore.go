@@ -6,6 +6,13 @@ import (
 	"github.com/nknorg/nkn/transaction"
 )
 
+// AddressTxn locates a transaction related to an address.
+type AddressTxn struct {
+	Hash   Uint256
+	Height uint32
+	Index  uint32
+}
+
 // ILedgerStore provides func with store package.
 type ILedgerStore interface {
 	SaveBlock(b *block.Block, fastAdd bool) error
@@ -17,8 +24,12 @@ type ILedgerStore interface {
 	GetHeader(hash Uint256) (*block.Header, error)
 	GetHeaderByHeight(height uint32) (*block.Header, error)
 	GetTransaction(hash Uint256) (*transaction.Transaction, error)
+	GetTransactionHeight(hash Uint256) (uint32, error)
+	GetTxnsByAddress(programHash Uint160, offset, limit int) ([]*AddressTxn, error)
+	GetAddressIndexStart() uint32
 	GetName(registrant []byte) (string, error)
 	GetRegistrant(name string) ([]byte, error)
+	GetNameExpiresAt(name string) (uint32, error)
 	IsSubscribed(topic string, bucket uint32, subscriber []byte, identifier string) (bool, error)
 	GetSubscribers(topic string, bucket uint32) (map[string]string, error)
 	GetSubscribersCount(topic string, bucket uint32) int
@@ -41,8 +52,13 @@ type ILedgerStore interface {
 	IsTxHashDuplicate(txhash Uint256) bool
 	IsBlockInStore(hash Uint256) bool
 	Rollback(b *block.Block) error
+	GetSnapshotHeight() uint32
 	GenerateStateRoot(b *block.Block, genesisBlockInitialized, needBeCommitted bool) (Uint256, error)
 	GetAsset(assetID Uint256) (name, symbol string, totalSupply Fixed64, precision uint32, err error)
+	GetAccountProof(addr Uint160) (*StateProof, error)
+	GetNameProof(name string) (*StateProof, error)
+	GetSubscriptionProof(topic string, bucket uint32, subscriber []byte, identifier string) (*StateProof, error)
+	ComputeTransactionChanges(txn *transaction.Transaction, height uint32, sim *TxnSimulation) error
 
 	Close()
 }
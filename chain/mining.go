@@ -83,6 +83,12 @@ func (bm *BuiltinMining) BuildBlock(ctx context.Context, height uint32, chordID
 			continue
 		}
 
+		if IsTxnExpired(txn, height) {
+			log.Warning("transaction has passed its valid until height")
+			txnCollection.Pop()
+			continue
+		}
+
 		totalTxsSize = totalTxsSize + txn.GetSize()
 		if totalTxsSize > config.MaxBlockSize {
 			break
@@ -0,0 +1,41 @@
+package pool
+
+import (
+	"time"
+
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/event"
+	"github.com/nknorg/nkn/transaction"
+)
+
+const (
+	TxnEventAdded    = "added"    // txn is ready to be included in a block
+	TxnEventQueued   = "queued"   // txn waits in the future queue for a nonce gap to fill
+	TxnEventReplaced = "replaced" // txn replaced a txn with the same nonce
+	TxnEventEvicted  = "evicted"  // txn left the pool without being included
+)
+
+// TxnEvent describes a change of the pool. It is sent to the subscribers of
+// event.TxnPoolChanged. Events are delivered asynchronously, so subscribers
+// should order them by Time if needed.
+type TxnEvent struct {
+	Type     string
+	Txn      *transaction.Transaction
+	Replaced *common.Uint256 // hash of the replaced txn, for TxnEventReplaced
+	Reason   string          // eviction reason, for TxnEventEvicted
+	Time     time.Time
+}
+
+func (tp *TxnPool) notifyTxnEvent(eventType string, txn *transaction.Transaction, replaced *transaction.Transaction, reason string) {
+	e := &TxnEvent{
+		Type:   eventType,
+		Txn:    txn,
+		Reason: reason,
+		Time:   time.Now(),
+	}
+	if replaced != nil {
+		hash := replaced.Hash()
+		e.Replaced = &hash
+	}
+	event.Queue.Notify(event.TxnPoolChanged, e)
+}
@@ -0,0 +1,280 @@
+package pool
+
+import (
+	"errors"
+	"time"
+
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/log"
+)
+
+const (
+	EvictReasonPoolFull   = "pool full"
+	EvictReasonExpired    = "expired"
+	EvictReasonValidUntil = "valid until height passed"
+	EvictReasonNonceGap   = "nonce gap"
+
+	// number of recent evictions kept for getrawmempool
+	maxEvictedHistory = 1024
+)
+
+var (
+	ErrTxnPoolFull = errors.New("txpool is full and txn fee per byte is too low")
+)
+
+// EvictedTxn records a txn that was removed from the pool without being
+// included in a block.
+type EvictedTxn struct {
+	Hash   common.Uint256
+	Sender common.Uint160
+	Nonce  uint64
+	Fee    int64
+	Size   int
+	Reason string
+	Time   time.Time
+}
+
+// PoolInfo summarizes the pool size and its limits.
+type PoolInfo struct {
+	TxnCount       int
+	TxnSize        int
+	MaxTxns        int
+	MaxSize        int
+	TxnTTL         time.Duration
+	MinFeeBump     uint32 // in percent
+	FutureTxnCount int
+	MaxFutureTxns  int
+}
+
+func feePerByte(txn *transaction.Transaction) float64 {
+	size := txn.GetSize()
+	if size == 0 {
+		return 0
+	}
+	return float64(txn.UnsignedTx.Fee) / float64(size)
+}
+
+func (tp *TxnPool) overLimits() bool {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	if config.Parameters.TxPoolMaxTxns > 0 && tp.txnCount > config.Parameters.TxPoolMaxTxns {
+		return true
+	}
+	if config.Parameters.TxPoolMaxSize > 0 && tp.txnSize > config.Parameters.TxPoolMaxSize {
+		return true
+	}
+	return false
+}
+
+// evictionCandidate returns the txn with the lowest fee per byte among the
+// highest-nonce txn of each sender and the nano pay txns. Only those can be
+// removed without leaving a nonce gap.
+func (tp *TxnPool) evictionCandidate() (*transaction.Transaction, *NonceSortedTxs) {
+	var candidate *transaction.Transaction
+	var candidateList *NonceSortedTxs
+	var candidateFee float64
+
+	consider := func(txn *transaction.Transaction, list *NonceSortedTxs) {
+		fee := feePerByte(txn)
+		if candidate == nil || fee < candidateFee {
+			candidate, candidateList, candidateFee = txn, list, fee
+		}
+	}
+
+	tp.TxLists.Range(func(_, v interface{}) bool {
+		if list, ok := v.(*NonceSortedTxs); ok {
+			if txn, err := list.SeekBack(); err == nil {
+				consider(txn, list)
+			}
+		}
+		return true
+	})
+	tp.NanoPayTxs.Range(func(_, v interface{}) bool {
+		consider(v.(*transaction.Transaction), nil)
+		return true
+	})
+
+	return candidate, candidateList
+}
+
+// evictTransactions removes the txns with the lowest fee per byte until the
+// pool is within its limits. ErrTxnPoolFull is returned if the txn that was
+// just added is evicted itself.
+func (tp *TxnPool) evictTransactions(added common.Uint256) error {
+	if !tp.overLimits() {
+		return nil
+	}
+
+	tp.blockValidationState.Lock()
+	defer tp.blockValidationState.Unlock()
+
+	evicted := make([]*transaction.Transaction, 0)
+	for tp.overLimits() {
+		txn, list := tp.evictionCandidate()
+		if txn == nil {
+			break
+		}
+		if list != nil {
+			list.PopBack()
+		} else {
+			tp.NanoPayTxs.Delete(txn.Hash())
+		}
+		tp.deleteTransactionFromMap(txn)
+		tp.recordEviction(txn, EvictReasonPoolFull)
+		evicted = append(evicted, txn)
+	}
+
+	if err := tp.CleanBlockValidationState(evicted); err != nil {
+		return err
+	}
+
+	for _, txn := range evicted {
+		if txn.Hash() == added {
+			return ErrTxnPoolFull
+		}
+	}
+
+	return nil
+}
+
+// dropExpiredTransactions removes txns that stayed in the pool longer than
+// TxPoolTxnTTL or can not be included in the next block because of their
+// valid until height. Txns of the same sender with a higher nonce are removed
+// too, since they can not be included in a block any more.
+func (tp *TxnPool) dropExpiredTransactions() {
+	expired := make(map[common.Uint256]string)
+
+	if config.Parameters.TxPoolTxnTTL > 0 {
+		deadline := time.Now().Add(-config.Parameters.TxPoolTxnTTL * time.Second)
+		tp.mu.Lock()
+		for hash, t := range tp.addedTime {
+			if t.Before(deadline) {
+				expired[hash] = EvictReasonExpired
+			}
+		}
+		tp.mu.Unlock()
+	}
+
+	height := chain.DefaultLedger.Store.GetHeight() + 1
+	tp.TxMap.Range(func(_, v interface{}) bool {
+		if txn, ok := v.(*transaction.Transaction); ok && chain.IsTxnExpired(txn, height) {
+			expired[txn.Hash()] = EvictReasonValidUntil
+		}
+		return true
+	})
+
+	if len(expired) == 0 {
+		return
+	}
+
+	tp.blockValidationState.Lock()
+	defer tp.blockValidationState.Unlock()
+
+	dropped := make([]*transaction.Transaction, 0)
+	tp.TxLists.Range(func(_, v interface{}) bool {
+		list, ok := v.(*NonceSortedTxs)
+		if !ok {
+			return true
+		}
+
+		txns := list.GetAllTransactions()
+		first := len(txns)
+		for i, txn := range txns {
+			if _, ok := expired[txn.Hash()]; ok {
+				first = i
+				break
+			}
+		}
+
+		for i := len(txns) - 1; i >= first; i-- {
+			txn, err := list.PopBack()
+			if err != nil {
+				break
+			}
+			reason, ok := expired[txn.Hash()]
+			if !ok {
+				reason = EvictReasonNonceGap
+			}
+			tp.deleteTransactionFromMap(txn)
+			tp.recordEviction(txn, reason)
+			dropped = append(dropped, txn)
+		}
+
+		return true
+	})
+	tp.NanoPayTxs.Range(func(k, v interface{}) bool {
+		txn := v.(*transaction.Transaction)
+		if reason, ok := expired[txn.Hash()]; ok {
+			tp.NanoPayTxs.Delete(k)
+			tp.deleteTransactionFromMap(txn)
+			tp.recordEviction(txn, reason)
+			dropped = append(dropped, txn)
+		}
+		return true
+	})
+
+	if err := tp.CleanBlockValidationState(dropped); err != nil {
+		log.Errorf("Clean block validation state of expired txns error: %v", err)
+	}
+}
+
+func (tp *TxnPool) recordEviction(txn *transaction.Transaction, reason string) {
+	e := &EvictedTxn{
+		Hash:   txn.Hash(),
+		Nonce:  txn.UnsignedTx.Nonce,
+		Fee:    txn.UnsignedTx.Fee,
+		Size:   txn.GetSize(),
+		Reason: reason,
+		Time:   time.Now(),
+	}
+	if len(txn.Programs) > 0 {
+		e.Sender, _ = common.ToCodeHash(txn.Programs[0].Code)
+	}
+
+	log.Debugf("Evict txn %s from txpool: %s", e.Hash.ToHexString(), reason)
+	tp.notifyTxnEvent(TxnEventEvicted, txn, nil, reason)
+
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	tp.evicted = append(tp.evicted, e)
+	if len(tp.evicted) > maxEvictedHistory {
+		tp.evicted = tp.evicted[len(tp.evicted)-maxEvictedHistory:]
+	}
+}
+
+// GetEvictedTransactions returns the most recent evictions, newest first.
+func (tp *TxnPool) GetEvictedTransactions() []*EvictedTxn {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	evicted := make([]*EvictedTxn, 0, len(tp.evicted))
+	for i := len(tp.evicted) - 1; i >= 0; i-- {
+		evicted = append(evicted, tp.evicted[i])
+	}
+	return evicted
+}
+
+// GetPoolInfo returns the current pool size and the configured limits.
+func (tp *TxnPool) GetPoolInfo() *PoolInfo {
+	// futureLock is never taken while holding mu
+	futureCount := tp.GetFutureTxnCount()
+
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	return &PoolInfo{
+		TxnCount:       tp.txnCount,
+		TxnSize:        tp.txnSize,
+		MaxTxns:        config.Parameters.TxPoolMaxTxns,
+		MaxSize:        config.Parameters.TxPoolMaxSize,
+		TxnTTL:         config.Parameters.TxPoolTxnTTL * time.Second,
+		MinFeeBump:     config.Parameters.TxPoolMinFeeBump,
+		FutureTxnCount: futureCount,
+		MaxFutureTxns:  config.Parameters.TxPoolMaxFutureTxns,
+	}
+}
@@ -0,0 +1,99 @@
+package pool
+
+import (
+	"testing"
+
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/vault"
+)
+
+func TestEvictionOrder(t *testing.T) {
+	maxTxns := config.Parameters.TxPoolMaxTxns
+	defer func() { config.Parameters.TxPoolMaxTxns = maxTxns }()
+
+	type testTxn struct {
+		sender int
+		nonce  uint64
+		fee    Fixed64
+	}
+	tests := []struct {
+		name    string
+		maxTxns int
+		txns    []testTxn
+		evicted []int // indexes into txns, in eviction order
+		wantErr error // of adding the last txn
+	}{
+		{
+			name:    "lowest fee",
+			maxTxns: 2,
+			txns:    []testTxn{{0, 0, 3}, {1, 0, 1}, {2, 0, 2}},
+			evicted: []int{1},
+		},
+		{
+			name:    "only highest nonce of sender",
+			maxTxns: 2,
+			txns:    []testTxn{{0, 0, 1}, {1, 0, 3}, {0, 1, 5}},
+			evicted: []int{1},
+		},
+		{
+			name:    "added txn",
+			maxTxns: 2,
+			txns:    []testTxn{{0, 0, 3}, {1, 0, 2}, {2, 0, 1}},
+			evicted: []int{2},
+			wantErr: ErrTxnPoolFull,
+		},
+		{
+			name:    "within limit",
+			maxTxns: 3,
+			txns:    []testTxn{{0, 0, 3}, {1, 0, 1}, {2, 0, 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accounts := []*vault.Account{newTestAccount(t), newTestAccount(t), newTestAccount(t)}
+			newTestLedger(t, accounts...)
+			config.Parameters.TxPoolMaxTxns = tt.maxTxns
+			tp := NewTxPool()
+
+			txns := make([]*transaction.Transaction, 0, len(tt.txns))
+			var err error
+			for i, tx := range tt.txns {
+				txn := newTestTransfer(t, accounts[tx.sender], tx.nonce, tx.fee)
+				txns = append(txns, txn)
+				err = tp.AppendTxnPool(txn)
+				if err != nil && i < len(tt.txns)-1 {
+					t.Fatal(err)
+				}
+			}
+			if err != tt.wantErr {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+
+			evicted := tp.GetEvictedTransactions()
+			if len(evicted) != len(tt.evicted) {
+				t.Fatalf("got %d evictions, want %d", len(evicted), len(tt.evicted))
+			}
+			for i, j := range tt.evicted {
+				e := evicted[len(evicted)-1-i]
+				if e.Hash != txns[j].Hash() || e.Reason != EvictReasonPoolFull {
+					t.Fatalf("eviction %d is txn with nonce %d and fee %d, want txn %d", i, e.Nonce, e.Fee, j)
+				}
+				if tp.GetTxnByHash(e.Hash) != nil {
+					t.Fatalf("evicted txn %d is still in the pool", j)
+				}
+			}
+			for j, txn := range txns {
+				evictedTxn := false
+				for _, k := range tt.evicted {
+					evictedTxn = evictedTxn || k == j
+				}
+				if !evictedTxn && tp.GetTxnByHash(txn.Hash()) == nil {
+					t.Fatalf("txn %d is not in the pool", j)
+				}
+			}
+		})
+	}
+}
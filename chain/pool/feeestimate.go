@@ -0,0 +1,185 @@
+package pool
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+)
+
+// probability that a txn paying the estimated fee is included within the
+// target number of blocks, assuming recent blocks are representative
+const feeEstimateConfidence = 0.95
+
+// DefaultFeeEstimateTxnSize is the txn size in bytes fees are estimated for if
+// the caller does not know the size of its txn, about that of a transfer.
+const DefaultFeeEstimateTxnSize = 256
+
+// FeeEstimate is the fee a txn of Size bytes should pay to be included within
+// Target blocks, together with the data it was derived from. Txns are ranked
+// by fee per byte, so the estimates scale with Size.
+type FeeEstimate struct {
+	Fee        common.Fixed64
+	BlockFee   common.Fixed64 // estimate from the last Blocks blocks
+	BacklogFee common.Fixed64 // estimate from the txns waiting in the pool
+	Size       int
+	Target     uint32
+	Blocks     int
+	Backlog    int
+}
+
+func isFeeTxn(txn *transaction.Transaction) bool {
+	switch txn.UnsignedTx.Payload.Type {
+	case pb.COINBASE_TYPE, pb.SIG_CHAIN_TXN_TYPE:
+		return false
+	}
+	return true
+}
+
+// blockMinFeePerByte returns the fee per byte a txn needed to get into b. It
+// is the lowest fee per byte in b if b was full, otherwise zero.
+func blockMinFeePerByte(b *block.Block) float64 {
+	count := 0
+	lowest := math.MaxFloat64
+	for _, txn := range b.Transactions {
+		if !isFeeTxn(txn) {
+			continue
+		}
+		count++
+		if f := feePerByte(txn); f < lowest {
+			lowest = f
+		}
+	}
+
+	if count >= int(config.Parameters.NumTxnPerBlock) || b.GetTxsSize() >= config.MaxBlockSize*9/10 {
+		return lowest
+	}
+	return 0
+}
+
+func feeForSize(feePerByte float64, size int) int64 {
+	fee := int64(math.Ceil(feePerByte * float64(size)))
+	if fee < config.Parameters.MinTxnFee {
+		return config.Parameters.MinTxnFee
+	}
+	return fee
+}
+
+// getBlockFeeRates returns the sorted min fee per byte of the last
+// FeeEstimateBlocks blocks. They are cached until the next block is saved.
+func (tp *TxnPool) getBlockFeeRates() ([]float64, error) {
+	store := chain.DefaultLedger.Store
+	blockHash := store.GetCurrentBlockHash()
+
+	tp.feeRatesLock.Lock()
+	defer tp.feeRatesLock.Unlock()
+
+	if tp.feeRates != nil && tp.feeRatesBlock == blockHash {
+		return tp.feeRates, nil
+	}
+
+	height, err := store.GetHeightByBlockHash(blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	numBlocks := config.Parameters.FeeEstimateBlocks
+	if numBlocks > height {
+		numBlocks = height
+	}
+
+	blockRates := make([]float64, 0, numBlocks)
+	for h := height - numBlocks + 1; h <= height; h++ {
+		b, err := store.GetBlockByHeight(h)
+		if err != nil {
+			return nil, err
+		}
+		blockRates = append(blockRates, blockMinFeePerByte(b))
+	}
+	sort.Float64s(blockRates)
+
+	tp.feeRates, tp.feeRatesBlock = blockRates, blockHash
+
+	return blockRates, nil
+}
+
+// EstimateFee estimates the fee needed for a txn of size bytes to be included
+// within target blocks. The block estimate is the fee that would have been
+// enough to get into at least one of target blocks with feeEstimateConfidence,
+// judging by the last FeeEstimateBlocks blocks. The backlog estimate is the fee
+// needed to outbid the txns in the pool that fill target blocks. The higher one
+// is used.
+func (tp *TxnPool) EstimateFee(target uint32, size int) (*FeeEstimate, error) {
+	if target == 0 {
+		return nil, errors.New("target should be at least 1 block")
+	}
+	if size <= 0 {
+		size = DefaultFeeEstimateTxnSize
+	}
+
+	blockRates, err := tp.getBlockFeeRates()
+	if err != nil {
+		return nil, err
+	}
+
+	blockFee := feeForSize(0, size)
+	if len(blockRates) > 0 {
+		// a block admits the fee with probability q, so one of target blocks
+		// does with probability 1-(1-q)^target
+		q := 1 - math.Pow(1-feeEstimateConfidence, 1/float64(target))
+		i := int(math.Ceil(q*float64(len(blockRates)))) - 1
+		if i < 0 {
+			i = 0
+		}
+		blockFee = feeForSize(blockRates[i], size)
+	}
+
+	backlog := make([]*transaction.Transaction, 0)
+	for _, txn := range tp.GetAllTransactions() {
+		if isFeeTxn(txn) {
+			backlog = append(backlog, txn)
+		}
+	}
+	rates := make([]float64, len(backlog))
+	sizes := make([]int, len(backlog))
+	order := make([]int, len(backlog))
+	for i, txn := range backlog {
+		rates[i], sizes[i], order[i] = feePerByte(txn), txn.GetSize(), i
+	}
+	sort.Slice(order, func(i, j int) bool { return rates[order[i]] > rates[order[j]] })
+
+	// walk the backlog from the highest fee per byte until target blocks are
+	// filled by count or size, a txn has to outbid the one there
+	backlogFee := feeForSize(0, size)
+	maxCount := int(target) * int(config.Parameters.NumTxnPerBlock)
+	maxSize := int(target) * config.MaxBlockSize
+	totalSize := 0
+	for n, i := range order {
+		totalSize += sizes[i]
+		if n+1 >= maxCount || totalSize >= maxSize {
+			backlogFee = feeForSize(rates[i], size) + 1
+			break
+		}
+	}
+
+	fee := blockFee
+	if backlogFee > fee {
+		fee = backlogFee
+	}
+
+	return &FeeEstimate{
+		Fee:        common.Fixed64(fee),
+		BlockFee:   common.Fixed64(blockFee),
+		BacklogFee: common.Fixed64(backlogFee),
+		Size:       size,
+		Target:     target,
+		Blocks:     len(blockRates),
+		Backlog:    len(backlog),
+	}, nil
+}
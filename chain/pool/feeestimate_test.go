@@ -0,0 +1,37 @@
+package pool
+
+import (
+	"testing"
+
+	. "github.com/nknorg/nkn/common"
+)
+
+func TestBlockFeeRatesCache(t *testing.T) {
+	newTestLedger(t, newTestAccount(t))
+	tp := NewTxPool()
+
+	rates, err := tp.getBlockFeeRates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rates) != 1 {
+		t.Fatalf("got %d block fee rates, want 1", len(rates))
+	}
+
+	// rates are not reloaded while the current block is the same
+	tp.feeRates[0] = 42
+	if rates, err = tp.getBlockFeeRates(); err != nil {
+		t.Fatal(err)
+	}
+	if rates[0] != 42 {
+		t.Fatal("block fee rates reloaded for the same block")
+	}
+
+	tp.feeRatesBlock = Uint256{1}
+	if rates, err = tp.getBlockFeeRates(); err != nil {
+		t.Fatal(err)
+	}
+	if rates[0] != 0 {
+		t.Fatal("block fee rates not reloaded after the current block changed")
+	}
+}
@@ -0,0 +1,260 @@
+package pool
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/log"
+)
+
+const (
+	EvictReasonFutureFull = "future queue full"
+	EvictReasonStaleNonce = "stale nonce"
+	EvictReasonInvalid    = "invalid"
+)
+
+var (
+	ErrNonceTooHigh    = errors.New("nonce is too far ahead of the next expected nonce")
+	ErrFutureQueueFull = errors.New("future txn queue is full and txn fee per byte is too low")
+)
+
+// futureTxn is a txn whose nonce is ahead of the next expected nonce of its
+// sender. It waits in the future queue until the nonces before it arrive.
+type futureTxn struct {
+	txn   *transaction.Transaction
+	added time.Time
+}
+
+// nextNonce returns the nonce the next ready txn of sender should have.
+func (tp *TxnPool) nextNonce(list *NonceSortedTxs, sender common.Uint160) (uint64, error) {
+	preNonce, err := list.GetLatestNonce()
+	if err != nil {
+		if err != ErrNonceSortedTxsEmpty {
+			return 0, errors.New("can not get nonce from txlist")
+		}
+		return chain.DefaultLedger.Store.GetNonce(sender), nil
+	}
+	return preNonce + 1, nil
+}
+
+// addFutureTxn queues txn until the nonces between expectNonce and its nonce
+// are filled. Nonces more than TxPoolFutureCap ahead are rejected, which also
+// bounds the queue size of each sender.
+func (tp *TxnPool) addFutureTxn(sender common.Uint160, expectNonce uint64, txn *transaction.Transaction) error {
+	nonce := txn.UnsignedTx.Nonce
+	if nonce >= expectNonce+uint64(config.Parameters.TxPoolFutureCap) {
+		return fmt.Errorf("%v: nonce %d, expected %d", ErrNonceTooHigh, nonce, expectNonce)
+	}
+
+	tp.futureLock.Lock()
+	defer tp.futureLock.Unlock()
+
+	queue, ok := tp.futureTxs[sender]
+	if !ok {
+		queue = make(map[uint64]*futureTxn)
+		tp.futureTxs[sender] = queue
+	}
+
+	if old, ok := queue[nonce]; ok {
+		if old.txn.Hash() == txn.Hash() {
+			return ErrDuplicatedTx
+		}
+		minFee := MinReplacementFee(old.txn.UnsignedTx.Fee, config.Parameters.TxPoolMinFeeBump)
+		if txn.UnsignedTx.Fee < minFee {
+			return fmt.Errorf("%v: txn with nonce %d has fee %d, replacement needs fee at least %d", ErrReplacementFeeTooLow, nonce, old.txn.UnsignedTx.Fee, minFee)
+		}
+		queue[nonce] = &futureTxn{txn: txn, added: time.Now()}
+		tp.notifyTxnEvent(TxnEventReplaced, txn, old.txn, "")
+		return nil
+	}
+
+	if config.Parameters.TxPoolMaxFutureTxns > 0 && tp.futureCount >= config.Parameters.TxPoolMaxFutureTxns {
+		if err := tp.evictFutureTxn(feePerByte(txn)); err != nil {
+			return err
+		}
+	}
+
+	queue[nonce] = &futureTxn{txn: txn, added: time.Now()}
+	tp.futureCount++
+	tp.notifyTxnEvent(TxnEventQueued, txn, nil, "")
+
+	return nil
+}
+
+// evictFutureTxn removes the queued future txn with the lowest fee per byte,
+// if that is lower than fee. futureLock must be held.
+func (tp *TxnPool) evictFutureTxn(fee float64) error {
+	var sender common.Uint160
+	var candidate *futureTxn
+	var candidateFee float64
+	for addr, queue := range tp.futureTxs {
+		for _, ft := range queue {
+			if f := feePerByte(ft.txn); candidate == nil || f < candidateFee {
+				sender, candidate, candidateFee = addr, ft, f
+			}
+		}
+	}
+
+	if candidate == nil || candidateFee >= fee {
+		return ErrFutureQueueFull
+	}
+
+	tp.removeFutureTxn(sender, candidate.txn.UnsignedTx.Nonce)
+	tp.recordEviction(candidate.txn, EvictReasonFutureFull)
+
+	return nil
+}
+
+// removeFutureTxn deletes a queued txn. futureLock must be held.
+func (tp *TxnPool) removeFutureTxn(sender common.Uint160, nonce uint64) *transaction.Transaction {
+	queue, ok := tp.futureTxs[sender]
+	if !ok {
+		return nil
+	}
+	ft, ok := queue[nonce]
+	if !ok {
+		return nil
+	}
+
+	delete(queue, nonce)
+	if len(queue) == 0 {
+		delete(tp.futureTxs, sender)
+	}
+	tp.futureCount--
+
+	return ft.txn
+}
+
+func (tp *TxnPool) takeFutureTxn(sender common.Uint160, nonce uint64) *futureTxn {
+	tp.futureLock.Lock()
+	defer tp.futureLock.Unlock()
+
+	ft, ok := tp.futureTxs[sender][nonce]
+	if !ok {
+		return nil
+	}
+	tp.removeFutureTxn(sender, nonce)
+
+	return ft
+}
+
+// requeueFutureTxn puts a txn taken from the future queue back, unless its
+// nonce has been queued again meanwhile.
+func (tp *TxnPool) requeueFutureTxn(sender common.Uint160, ft *futureTxn) {
+	tp.futureLock.Lock()
+	defer tp.futureLock.Unlock()
+
+	queue, ok := tp.futureTxs[sender]
+	if !ok {
+		queue = make(map[uint64]*futureTxn)
+		tp.futureTxs[sender] = queue
+	}
+	if _, ok := queue[ft.txn.UnsignedTx.Nonce]; ok {
+		return
+	}
+	queue[ft.txn.UnsignedTx.Nonce] = ft
+	tp.futureCount++
+}
+
+// promoteFutureTxns moves queued txns of sender into the pool as long as the
+// next expected nonce is in the future queue and the txn list of sender has
+// room for it.
+func (tp *TxnPool) promoteFutureTxns(sender common.Uint160) {
+	for {
+		list, err := tp.getOrNewList(sender)
+		if err != nil {
+			return
+		}
+		if list.Full() {
+			return
+		}
+		expectNonce, err := tp.nextNonce(list, sender)
+		if err != nil {
+			return
+		}
+
+		ft := tp.takeFutureTxn(sender, expectNonce)
+		if ft == nil {
+			return
+		}
+
+		txn := ft.txn
+		if err := tp.appendTxn(txn); err != nil {
+			if err == ErrTxnListFull {
+				// filled up since checked, promote it when there is room again
+				tp.requeueFutureTxn(sender, ft)
+				return
+			}
+			txnHash := txn.Hash()
+			log.Debugf("Drop future txn %s on promotion: %v", txnHash.ToHexString(), err)
+			if err != ErrTxnPoolFull {
+				tp.recordEviction(txn, EvictReasonInvalid)
+			}
+			return
+		}
+	}
+}
+
+// cleanFutureTxns is called after a block is saved. It drops queued txns whose
+// nonce has been used, which stayed longer than TxPoolFutureTTL or whose valid
+// until height has passed, and promotes the ones that became ready.
+func (tp *TxnPool) cleanFutureTxns() {
+	var deadline time.Time
+	if config.Parameters.TxPoolFutureTTL > 0 {
+		deadline = time.Now().Add(-config.Parameters.TxPoolFutureTTL * time.Second)
+	}
+
+	height := chain.DefaultLedger.Store.GetHeight() + 1
+
+	tp.futureLock.Lock()
+	senders := make([]common.Uint160, 0, len(tp.futureTxs))
+	for sender, queue := range tp.futureTxs {
+		ledgerNonce := chain.DefaultLedger.Store.GetNonce(sender)
+		for nonce, ft := range queue {
+			reason := ""
+			if nonce < ledgerNonce {
+				reason = EvictReasonStaleNonce
+			} else if !deadline.IsZero() && ft.added.Before(deadline) {
+				reason = EvictReasonExpired
+			} else if chain.IsTxnExpired(ft.txn, height) {
+				reason = EvictReasonValidUntil
+			}
+			if reason != "" {
+				tp.removeFutureTxn(sender, nonce)
+				tp.recordEviction(ft.txn, reason)
+			}
+		}
+		if _, ok := tp.futureTxs[sender]; ok {
+			senders = append(senders, sender)
+		}
+	}
+	tp.futureLock.Unlock()
+
+	for _, sender := range senders {
+		tp.promoteFutureTxns(sender)
+	}
+}
+
+func (tp *TxnPool) getFutureTxns(sender common.Uint160) []*transaction.Transaction {
+	tp.futureLock.Lock()
+	defer tp.futureLock.Unlock()
+
+	txns := make([]*transaction.Transaction, 0, len(tp.futureTxs[sender]))
+	for _, ft := range tp.futureTxs[sender] {
+		txns = append(txns, ft.txn)
+	}
+	return txns
+}
+
+// GetFutureTxnCount returns the number of txns in the future queue.
+func (tp *TxnPool) GetFutureTxnCount() int {
+	tp.futureLock.Lock()
+	defer tp.futureLock.Unlock()
+
+	return tp.futureCount
+}
@@ -0,0 +1,110 @@
+package pool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nknorg/nkn/util/config"
+)
+
+func TestFutureTxnPromotion(t *testing.T) {
+	tests := []struct {
+		name    string
+		nonces  []uint64
+		pending int
+		future  int
+		wantErr bool
+	}{
+		{name: "in order", nonces: []uint64{0, 1, 2}, pending: 3},
+		{name: "gap filled", nonces: []uint64{0, 2, 3, 1}, pending: 4},
+		{name: "gap filled first", nonces: []uint64{2, 1, 0}, pending: 3},
+		{name: "gap left", nonces: []uint64{0, 2, 3}, pending: 1, future: 2},
+		{name: "nothing ready", nonces: []uint64{1, 2}, future: 2},
+		{name: "too far ahead", nonces: []uint64{0, uint64(config.Parameters.TxPoolFutureCap) + 1}, pending: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := newTestAccount(t)
+			newTestLedger(t, account)
+			tp := NewTxPool()
+
+			var err error
+			for _, nonce := range tt.nonces {
+				if err = tp.AppendTxnPool(newTestTransfer(t, account, nonce, 0)); err != nil {
+					break
+				}
+			}
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+
+			if n := pendingCount(tp, account.ProgramHash); n != tt.pending {
+				t.Fatalf("got %d pending txns, want %d", n, tt.pending)
+			}
+			if n := tp.GetFutureTxnCount(); n != tt.future {
+				t.Fatalf("got %d future txns, want %d", n, tt.future)
+			}
+		})
+	}
+}
+
+func TestCleanFutureTxns(t *testing.T) {
+	ttl := config.Parameters.TxPoolFutureTTL
+	defer func() { config.Parameters.TxPoolFutureTTL = ttl }()
+	config.Parameters.TxPoolFutureTTL = 60
+
+	// the ledger is at height 1, so txns valid until height 1 are expired
+	tests := []struct {
+		name       string
+		nonce      uint64
+		age        time.Duration
+		validUntil uint32
+		pending    int
+		future     int
+		reason     string
+	}{
+		{name: "kept", nonce: 2, age: time.Second, future: 1},
+		{name: "valid until later", nonce: 2, validUntil: 2, future: 1},
+		{name: "ttl passed", nonce: 2, age: 2 * time.Minute, reason: EvictReasonExpired},
+		{name: "valid until passed", nonce: 2, validUntil: 1, reason: EvictReasonValidUntil},
+		{name: "promoted", nonce: 0, pending: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := newTestAccount(t)
+			newTestLedger(t, account)
+			tp := NewTxPool()
+
+			txn := newTestTransfer(t, account, tt.nonce, 0)
+			if tt.validUntil > 0 {
+				txn.UnsignedTx.ValidUntilHeight = tt.validUntil
+				signTestTxn(t, txn, account)
+			}
+			if err := tp.addFutureTxn(account.ProgramHash, 0, txn); err != nil {
+				t.Fatal(err)
+			}
+			tp.futureTxs[account.ProgramHash][tt.nonce].added = time.Now().Add(-tt.age)
+
+			tp.cleanFutureTxns()
+
+			if n := pendingCount(tp, account.ProgramHash); n != tt.pending {
+				t.Fatalf("got %d pending txns, want %d", n, tt.pending)
+			}
+			if n := tp.GetFutureTxnCount(); n != tt.future {
+				t.Fatalf("got %d future txns, want %d", n, tt.future)
+			}
+			evicted := tp.GetEvictedTransactions()
+			if tt.reason == "" {
+				if len(evicted) > 0 {
+					t.Fatalf("txn evicted for %q", evicted[0].Reason)
+				}
+				return
+			}
+			if len(evicted) != 1 || evicted[0].Hash != txn.Hash() || evicted[0].Reason != tt.reason {
+				t.Fatalf("got evictions %v, want txn evicted for %q", evicted, tt.reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package pool
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/nknorg/nkn/common/serialization"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/log"
+)
+
+// txJournal is an append-only file of the txns accepted into the pool, so
+// that pending txns survive a node restart. Each record is a txn marshaled as
+// var bytes. The journal is rewritten with the current pool content whenever
+// txns leave the pool.
+type txJournal struct {
+	sync.Mutex
+	path   string
+	writer *os.File
+}
+
+func newTxJournal(path string) *txJournal {
+	return &txJournal{path: path}
+}
+
+// load reads all txns in the journal and calls add for each of them. A
+// truncated last record, e.g. after a crash, ends loading without error.
+func (j *txJournal) load(add func(*transaction.Transaction) error) (int, int, error) {
+	buf, err := ioutil.ReadFile(j.path)
+	if os.IsNotExist(err) {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+
+	r := bytes.NewReader(buf)
+	total, dropped := 0, 0
+	for {
+		data, err := readJournalRecord(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Warningf("Txpool journal %s is truncated: %v", j.path, err)
+			break
+		}
+
+		total++
+		txn := &transaction.Transaction{}
+		if err := txn.Unmarshal(data); err != nil {
+			log.Warningf("Discard undecodable txn in txpool journal: %v", err)
+			dropped++
+			continue
+		}
+		if err := add(txn); err != nil {
+			txnHash := txn.Hash()
+			log.Infof("Discard txn %s from txpool journal: %v", txnHash.ToHexString(), err)
+			dropped++
+		}
+	}
+
+	return total, dropped, nil
+}
+
+// readJournalRecord reads the next record, or returns io.EOF if there is none.
+// Unlike serialization.ReadVarBytes it fails on a short record instead of
+// returning the part that could be read.
+func readJournalRecord(r *bytes.Reader) ([]byte, error) {
+	if r.Len() == 0 {
+		return nil, io.EOF
+	}
+
+	size, err := serialization.ReadVarUint(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	if size > uint64(r.Len()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func writeJournalRecord(w io.Writer, txn *transaction.Transaction) error {
+	data, err := txn.Marshal()
+	if err != nil {
+		return err
+	}
+
+	buff := bytes.NewBuffer(nil)
+	if err := serialization.WriteVarBytes(buff, data); err != nil {
+		return err
+	}
+
+	_, err = w.Write(buff.Bytes())
+	return err
+}
+
+// insert appends txn to the journal.
+func (j *txJournal) insert(txn *transaction.Transaction) error {
+	j.Lock()
+	defer j.Unlock()
+
+	if j.writer == nil {
+		return nil
+	}
+
+	return writeJournalRecord(j.writer, txn)
+}
+
+// rotate replaces the journal with the txns returned by getTxns and keeps
+// appending to the new file. getTxns is called with the journal locked, so a
+// txn added to the pool after it is taken is inserted into the new file.
+func (j *txJournal) rotate(getTxns func() []*transaction.Transaction) error {
+	j.Lock()
+	defer j.Unlock()
+
+	txns := getTxns()
+
+	if j.writer != nil {
+		j.writer.Close()
+		j.writer = nil
+	}
+
+	tmp := j.path + ".new"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	for _, txn := range txns {
+		if err := writeJournalRecord(w, txn); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp, j.path); err != nil {
+		return err
+	}
+
+	j.writer, err = os.OpenFile(j.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	return err
+}
+
+// LoadJournal replays the txns in the journal at path into the pool, verifying
+// each of them against the current ledger, and then keeps the journal in sync
+// with the pool. Txns that are no longer valid are discarded and logged.
+func (tp *TxnPool) LoadJournal(path string) error {
+	journal := newTxJournal(path)
+
+	total, dropped, err := journal.load(tp.AppendTxnPool)
+	if err != nil {
+		return err
+	}
+	if total > 0 {
+		log.Infof("Loaded %d txns from txpool journal %s, %d discarded", total-dropped, path, dropped)
+	}
+
+	if err := journal.rotate(tp.getJournalTransactions); err != nil {
+		return err
+	}
+
+	tp.journal = journal
+
+	return nil
+}
+
+// getJournalTransactions returns the txns in the pool in an order they can be
+// added back in, lowest nonce first for each sender and queued txns last.
+func (tp *TxnPool) getJournalTransactions() []*transaction.Transaction {
+	txns := tp.GetAllTransactions()
+
+	tp.futureLock.Lock()
+	for _, queue := range tp.futureTxs {
+		for _, ft := range queue {
+			txns = append(txns, ft.txn)
+		}
+	}
+	tp.futureLock.Unlock()
+
+	return txns
+}
+
+func (tp *TxnPool) journalTxn(txn *transaction.Transaction) {
+	if tp.journal == nil || txn.UnsignedTx.Payload.Type == pb.SIG_CHAIN_TXN_TYPE {
+		return
+	}
+	if err := tp.journal.insert(txn); err != nil {
+		log.Warningf("Write txn to txpool journal error: %v", err)
+	}
+}
+
+func (tp *TxnPool) rotateJournal() {
+	if tp.journal == nil {
+		return
+	}
+	if err := tp.journal.rotate(tp.getJournalTransactions); err != nil {
+		log.Warningf("Compact txpool journal error: %v", err)
+	}
+}
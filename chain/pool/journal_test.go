@@ -0,0 +1,205 @@
+package pool
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/transaction"
+)
+
+func newTestJournalPath(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "txpool-journal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return filepath.Join(dir, "txpool.journal")
+}
+
+func journalHashes(t *testing.T, path string) map[Uint256]bool {
+	hashes := make(map[Uint256]bool)
+	_, _, err := newTxJournal(path).load(func(txn *transaction.Transaction) error {
+		hashes[txn.Hash()] = true
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hashes
+}
+
+func TestJournalRotateRoundTrip(t *testing.T) {
+	account := newTestAccount(t)
+	newTestLedger(t, account)
+
+	path := newTestJournalPath(t)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	tp := NewTxPool()
+	if err := tp.LoadJournal(path); err != nil {
+		t.Fatal(err)
+	}
+
+	// nonce 3 waits in the future queue
+	txns := make([]*transaction.Transaction, 0)
+	for _, nonce := range []uint64{0, 1, 3} {
+		txn := newTestTransfer(t, account, nonce, 0)
+		if err := tp.AppendTxnPool(txn); err != nil {
+			t.Fatal(err)
+		}
+		txns = append(txns, txn)
+	}
+
+	// a txn added to the pool while the journal is rotated must end up in
+	// the new file
+	late := newTestTransfer(t, account, 2, 0)
+	done := make(chan error, 1)
+	err := tp.journal.rotate(func() []*transaction.Transaction {
+		snapshot := tp.getJournalTransactions()
+		go func() { done <- tp.AppendTxnPool(late) }()
+		return snapshot
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	txns = append(txns, late)
+
+	hashes := journalHashes(t, path)
+	for _, txn := range txns {
+		if !hashes[txn.Hash()] {
+			t.Fatalf("txn with nonce %d is missing from the journal", txn.UnsignedTx.Nonce)
+		}
+	}
+
+	tp.rotateJournal()
+	if hashes := journalHashes(t, path); len(hashes) != len(txns) {
+		t.Fatalf("got %d txns in the rotated journal, want %d", len(hashes), len(txns))
+	}
+
+	loaded := NewTxPool()
+	if err := loaded.LoadJournal(path); err != nil {
+		t.Fatal(err)
+	}
+	for _, txn := range txns {
+		if loaded.GetTxnByHash(txn.Hash()) == nil {
+			t.Fatalf("txn with nonce %d is not loaded from the journal", txn.UnsignedTx.Nonce)
+		}
+	}
+	if n := loaded.GetFutureTxnCount(); n != 0 {
+		t.Fatalf("got %d future txns after load, want 0", n)
+	}
+}
+
+func TestJournalReplay(t *testing.T) {
+	account := newTestAccount(t)
+
+	tests := []struct {
+		name    string
+		records func() [][]byte
+		total   int
+		dropped int
+		pending int
+		future  int
+	}{
+		{
+			name: "in order",
+			records: func() [][]byte {
+				return testJournalRecords(t, newTestTransfer(t, account, 0, 0), newTestTransfer(t, account, 1, 0))
+			},
+			total: 2, pending: 2,
+		},
+		{
+			name: "queued before ready",
+			records: func() [][]byte {
+				return testJournalRecords(t, newTestTransfer(t, account, 1, 0), newTestTransfer(t, account, 0, 0))
+			},
+			total: 2, pending: 2,
+		},
+		{
+			name: "gap",
+			records: func() [][]byte {
+				return testJournalRecords(t, newTestTransfer(t, account, 0, 0), newTestTransfer(t, account, 2, 0))
+			},
+			total: 2, pending: 1, future: 1,
+		},
+		{
+			name: "duplicate",
+			records: func() [][]byte {
+				txn := newTestTransfer(t, account, 0, 0)
+				return testJournalRecords(t, txn, txn)
+			},
+			total: 2, dropped: 1, pending: 1,
+		},
+		{
+			name: "bad signature",
+			records: func() [][]byte {
+				txn := newTestTransfer(t, account, 0, 0)
+				txn.UnsignedTx.Fee++
+				return testJournalRecords(t, txn, newTestTransfer(t, account, 0, 0))
+			},
+			total: 2, dropped: 1, pending: 1,
+		},
+		{
+			name: "undecodable",
+			records: func() [][]byte {
+				records := testJournalRecords(t, newTestTransfer(t, account, 0, 0))
+				return append([][]byte{{0x01, 0xff}}, records...)
+			},
+			total: 2, dropped: 1, pending: 1,
+		},
+		{
+			name: "truncated",
+			records: func() [][]byte {
+				records := testJournalRecords(t, newTestTransfer(t, account, 0, 0), newTestTransfer(t, account, 1, 0))
+				records[1] = records[1][:len(records[1])/2]
+				return records
+			},
+			total: 1, pending: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestLedger(t, account)
+
+			path := newTestJournalPath(t)
+			defer os.RemoveAll(filepath.Dir(path))
+			if err := ioutil.WriteFile(path, bytes.Join(tt.records(), nil), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			tp := NewTxPool()
+			total, dropped, err := newTxJournal(path).load(tp.AppendTxnPool)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if total != tt.total || dropped != tt.dropped {
+				t.Fatalf("got %d txns with %d dropped, want %d with %d dropped", total, dropped, tt.total, tt.dropped)
+			}
+			if n := pendingCount(tp, account.ProgramHash); n != tt.pending {
+				t.Fatalf("got %d pending txns, want %d", n, tt.pending)
+			}
+			if n := tp.GetFutureTxnCount(); n != tt.future {
+				t.Fatalf("got %d future txns, want %d", n, tt.future)
+			}
+		})
+	}
+}
+
+func testJournalRecords(t *testing.T, txns ...*transaction.Transaction) [][]byte {
+	records := make([][]byte, 0, len(txns))
+	for _, txn := range txns {
+		buff := bytes.NewBuffer(nil)
+		if err := writeJournalRecord(buff, txn); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, buff.Bytes())
+	}
+	return records
+}
@@ -114,6 +114,36 @@ func (nst *NonceSortedTxs) Seek() (*transaction.Transaction, error) {
 	return nst.txs[nst.idx[0]], nil
 }
 
+// SeekBack returns the txn with the highest nonce.
+func (nst *NonceSortedTxs) SeekBack() (*transaction.Transaction, error) {
+	nst.mu.RLock()
+	defer nst.mu.RUnlock()
+
+	if nst.empty() {
+		return nil, ErrNonceSortedTxsEmpty
+	}
+
+	return nst.txs[nst.idx[nst.len()-1]], nil
+}
+
+// PopBack removes and returns the txn with the highest nonce, which keeps the
+// remaining nonces continuous.
+func (nst *NonceSortedTxs) PopBack() (*transaction.Transaction, error) {
+	nst.mu.Lock()
+	defer nst.mu.Unlock()
+
+	if nst.empty() {
+		return nil, ErrNonceSortedTxsEmpty
+	}
+
+	hash := nst.idx[nst.len()-1]
+	nst.idx = nst.idx[:nst.len()-1]
+	tx := nst.txs[hash]
+	delete(nst.txs, hash)
+
+	return tx, nil
+}
+
 func (nst *NonceSortedTxs) getNonce(hash common.Uint256) uint64 {
 	if tx, ok := nst.txs[hash]; ok {
 		return tx.UnsignedTx.Nonce
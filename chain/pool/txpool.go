@@ -2,8 +2,10 @@ package pool
 
 import (
 	"errors"
+	"fmt"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/nknorg/nkn/chain"
 	"github.com/nknorg/nkn/common"
@@ -14,7 +16,9 @@ import (
 )
 
 var (
-	ErrDuplicatedTx = errors.New("duplicate transaction check failed")
+	ErrDuplicatedTx         = errors.New("duplicate transaction check failed")
+	ErrReplacementFeeTooLow = errors.New("replacement transaction fee too low")
+	ErrTxnListFull          = errors.New("txpool is full")
 )
 
 // TxnPool is a list of txns that need to by add to ledger sent by user.
@@ -24,13 +28,46 @@ type TxnPool struct {
 	TxShortHashMap       sync.Map
 	NanoPayTxs           sync.Map // tx with nano pay type.
 	blockValidationState *chain.BlockValidationState
+
+	mu        sync.Mutex
+	txnCount  int                          // number of txns in TxMap
+	txnSize   int                          // total size of txns in TxMap
+	addedTime map[common.Uint256]time.Time // when each txn entered the pool
+	evicted   []*EvictedTxn                // most recent evictions, oldest first
+
+	futureLock  sync.Mutex
+	futureTxs   map[common.Uint160]map[uint64]*futureTxn // gapped nonce txns by sender
+	futureCount int
+
+	journal *txJournal
+
+	feeRatesLock  sync.Mutex
+	feeRatesBlock common.Uint256 // block the cached fee rates end at
+	feeRates      []float64      // sorted min fee per byte of recent blocks
+
+	// held for reading while txns are added or removed, and for writing while
+	// the pool is rebuilt after a rollback
+	rebuildLock sync.RWMutex
 }
 
 func NewTxPool() *TxnPool {
-	return &TxnPool{blockValidationState: chain.NewBlockValidationState()}
+	return &TxnPool{
+		blockValidationState: chain.NewBlockValidationState(),
+		addedTime:            make(map[common.Uint256]time.Time),
+		futureTxs:            make(map[common.Uint160]map[uint64]*futureTxn),
+	}
 }
 
 func (tp *TxnPool) AppendTxnPool(txn *transaction.Transaction) error {
+	tp.rebuildLock.RLock()
+	defer tp.rebuildLock.RUnlock()
+
+	return tp.addTxn(txn, true)
+}
+
+// addTxn verifies txn and adds it to the pool, and writes it to the journal if
+// journal is true.
+func (tp *TxnPool) addTxn(txn *transaction.Transaction, journal bool) error {
 	sender, err := txn.GetProgramHashes()
 	if err != nil {
 		return err
@@ -50,6 +87,21 @@ func (tp *TxnPool) AppendTxnPool(txn *transaction.Transaction) error {
 		return err
 	}
 
+	if err := tp.appendTxn(txn); err != nil {
+		return err
+	}
+
+	if journal {
+		tp.journalTxn(txn)
+	}
+
+	// 6. move queued txns that follow txn into the pool
+	tp.promoteFutureTxns(sender[0])
+
+	return nil
+}
+
+func (tp *TxnPool) appendTxn(txn *transaction.Transaction) error {
 	// 3. verify txn with ledger
 	if err := chain.VerifyTransactionWithLedger(txn); err != nil {
 		return err
@@ -60,6 +112,11 @@ func (tp *TxnPool) AppendTxnPool(txn *transaction.Transaction) error {
 		return err
 	}
 
+	// 5. make room for txn if the pool is over its limits
+	if err := tp.evictTransactions(txn.Hash()); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -103,6 +160,7 @@ func (tp *TxnPool) processTx(txn *transaction.Transaction) error {
 		return err
 	}
 
+	var replaced *transaction.Transaction
 	switch txn.UnsignedTx.Payload.Type {
 	case pb.SIG_CHAIN_TXN_TYPE:
 		// sigchain txn should not be added to txn pool
@@ -118,6 +176,10 @@ func (tp *TxnPool) processTx(txn *transaction.Transaction) error {
 		tp.blockValidationState.Commit()
 	default:
 		if oldTxn, err := list.Get(txn.UnsignedTx.Nonce); err == nil {
+			minFee := MinReplacementFee(oldTxn.UnsignedTx.Fee, config.Parameters.TxPoolMinFeeBump)
+			if txn.UnsignedTx.Fee < minFee {
+				return fmt.Errorf("%v: txn with nonce %d has fee %d, replacement needs fee at least %d", ErrReplacementFeeTooLow, txn.UnsignedTx.Nonce, oldTxn.UnsignedTx.Fee, minFee)
+			}
 			log.Warning("replace old tx")
 			tp.blockValidationState.Lock()
 			defer tp.blockValidationState.Unlock()
@@ -134,23 +196,21 @@ func (tp *TxnPool) processTx(txn *transaction.Transaction) error {
 			}
 
 			tp.deleteTransactionFromMap(oldTxn)
+			replaced = oldTxn
 		} else if list.Full() {
-			return errors.New("txpool is full")
+			return ErrTxnListFull
 		} else {
-			var expectNonce uint64
-			if preNonce, err := list.GetLatestNonce(); err != nil {
-				if err != ErrNonceSortedTxsEmpty {
-					return errors.New("can not get nonce from txlist")
-				}
-
-				expectNonce = chain.DefaultLedger.Store.GetNonce(sender[0])
-			} else {
-				expectNonce = preNonce + 1
+			expectNonce, err := tp.nextNonce(list, sender[0])
+			if err != nil {
+				return err
 			}
 
-			if txn.UnsignedTx.Nonce != expectNonce {
+			if txn.UnsignedTx.Nonce < expectNonce {
 				return errors.New("the nonce is not continuous")
 			}
+			if txn.UnsignedTx.Nonce > expectNonce {
+				return tp.addFutureTxn(sender[0], expectNonce, txn)
+			}
 
 			tp.blockValidationState.Lock()
 			defer tp.blockValidationState.Unlock()
@@ -168,6 +228,12 @@ func (tp *TxnPool) processTx(txn *transaction.Transaction) error {
 
 	tp.addTransactionToMap(txn)
 
+	if replaced != nil {
+		tp.notifyTxnEvent(TxnEventReplaced, txn, replaced, "")
+	} else {
+		tp.notifyTxnEvent(TxnEventAdded, txn, nil, "")
+	}
+
 	return nil
 }
 
@@ -197,6 +263,7 @@ func (tp *TxnPool) GetAllTransactionsBySender(programHash common.Uint160) []*tra
 			}
 		}
 	}
+	txns = append(txns, tp.getFutureTxns(programHash)...)
 	tp.NanoPayTxs.Range(func(k, v interface{}) bool {
 		txns = append(txns, v.(*transaction.Transaction))
 		return true
@@ -315,6 +382,9 @@ func (tp *TxnPool) GetAllTransactionLists() map[common.Uint160][]*transaction.Tr
 }
 
 func (tp *TxnPool) CleanSubmittedTransactions(txns []*transaction.Transaction) error {
+	tp.rebuildLock.RLock()
+	defer tp.rebuildLock.RUnlock()
+
 	txnsInPool := make([]*transaction.Transaction, 0)
 
 	// clean submitted txs
@@ -335,7 +405,9 @@ func (tp *TxnPool) CleanSubmittedTransactions(txns []*transaction.Transaction) e
 					if _, err := list.Get(txNonce); err == nil {
 						nonce := list.getNonce(list.idx[0])
 						for i := 0; uint64(i) <= txNonce-nonce; i++ {
-							list.Pop()
+							if popped, err := list.Pop(); err == nil {
+								tp.deleteTransactionFromMap(popped)
+							}
 						}
 					}
 				}
@@ -349,17 +421,94 @@ func (tp *TxnPool) CleanSubmittedTransactions(txns []*transaction.Transaction) e
 	}
 
 	tp.blockValidationState.Lock()
-	defer tp.blockValidationState.Unlock()
-	return tp.CleanBlockValidationState(txnsInPool)
+	err := tp.CleanBlockValidationState(txnsInPool)
+	tp.blockValidationState.Unlock()
+	if err != nil {
+		return err
+	}
+
+	tp.dropExpiredTransactions()
+	tp.cleanFutureTxns()
+	tp.rotateJournal()
+
+	return nil
+}
+
+// ReaddTransactions puts transactions of rolled back blocks back into the
+// pool. Pending transactions are added again after them so that the nonces of
+// each sender stay continuous from the rolled back ledger state. The journal is
+// rewritten once the pool is rebuilt instead of appending every readded txn.
+func (tp *TxnPool) ReaddTransactions(txns []*transaction.Transaction) {
+	tp.rebuildLock.Lock()
+	defer tp.rebuildLock.Unlock()
+
+	pending := tp.GetAllTransactions()
+
+	for _, m := range []*sync.Map{&tp.TxLists, &tp.TxMap, &tp.TxShortHashMap, &tp.NanoPayTxs} {
+		m.Range(func(k, _ interface{}) bool {
+			m.Delete(k)
+			return true
+		})
+	}
+
+	// keep the time pending txns were first added, so readding them does not
+	// extend their time to live
+	tp.mu.Lock()
+	tp.txnCount = 0
+	tp.txnSize = 0
+	tp.mu.Unlock()
+
+	tp.blockValidationState.Lock()
+	tp.blockValidationState.RefreshBlockValidationState(nil)
+	tp.blockValidationState.Unlock()
+
+	for _, txn := range append(txns, pending...) {
+		switch txn.UnsignedTx.Payload.Type {
+		case pb.COINBASE_TYPE:
+			continue
+		case pb.SIG_CHAIN_TXN_TYPE:
+			continue
+		}
+		if err := tp.addTxn(txn, false); err != nil {
+			txnHash := txn.Hash()
+			log.Debugf("Drop txn %s after rollback: %v", txnHash.ToHexString(), err)
+		}
+	}
+
+	tp.mu.Lock()
+	for hash := range tp.addedTime {
+		if _, ok := tp.TxMap.Load(hash); !ok {
+			delete(tp.addedTime, hash)
+		}
+	}
+	tp.mu.Unlock()
+
+	tp.rotateJournal()
 }
 
 func (tp *TxnPool) addTransactionToMap(txn *transaction.Transaction) {
-	tp.TxMap.Store(txn.Hash(), txn)
+	hash := txn.Hash()
+	if _, loaded := tp.TxMap.LoadOrStore(hash, txn); !loaded {
+		tp.mu.Lock()
+		tp.txnCount++
+		tp.txnSize += txn.GetSize()
+		if _, ok := tp.addedTime[hash]; !ok {
+			tp.addedTime[hash] = time.Now()
+		}
+		tp.mu.Unlock()
+	}
 	tp.TxShortHashMap.Store(shortHashToKey(txn.ShortHash(config.ShortHashSalt, config.ShortHashSize)), txn)
 }
 
 func (tp *TxnPool) deleteTransactionFromMap(txn *transaction.Transaction) {
-	tp.TxMap.Delete(txn.Hash())
+	hash := txn.Hash()
+	if _, loaded := tp.TxMap.LoadAndDelete(hash); loaded {
+		tp.mu.Lock()
+		tp.txnCount--
+		tp.txnSize -= txn.GetSize()
+		delete(tp.addedTime, hash)
+		tp.mu.Unlock()
+	}
 	tp.TxShortHashMap.Delete(shortHashToKey(txn.ShortHash(config.ShortHashSalt, config.ShortHashSize)))
 }
 
@@ -383,23 +532,79 @@ func (tp *TxnPool) GetTxnByCount(num int) (map[common.Uint256]*transaction.Trans
 	return txmap, nil
 }
 
+// GetNonceByTxnPool returns the first missing nonce of addr after the
+// contiguous run of nonces that starts at its ledger nonce, counting pending
+// txns first and then txns queued in the future queue.
 func (tp *TxnPool) GetNonceByTxnPool(addr common.Uint160) (uint64, error) {
-	v, ok := tp.TxLists.Load(addr)
-	if !ok {
+	var expectedNonce uint64
+	hasPending := false
+	if v, ok := tp.TxLists.Load(addr); ok {
+		list, ok := v.(*NonceSortedTxs)
+		if !ok {
+			return 0, errors.New("convert to NonceSortedTxs error")
+		}
+		var err error
+		expectedNonce, err = tp.nextNonce(list, addr)
+		if err != nil {
+			return 0, err
+		}
+		hasPending = !list.Empty()
+	} else {
+		expectedNonce = chain.DefaultLedger.Store.GetNonce(addr)
+	}
+
+	tp.futureLock.Lock()
+	defer tp.futureLock.Unlock()
+
+	queue, hasFuture := tp.futureTxs[addr]
+	if !hasPending && !hasFuture {
 		return 0, errors.New("no transactions in transaction pool")
 	}
-	list, ok := v.(*NonceSortedTxs)
-	if !ok {
-		return 0, errors.New("convert to NonceSortedTxs error")
+	for {
+		if _, ok := queue[expectedNonce]; !ok {
+			break
+		}
+		expectedNonce++
 	}
 
-	pendingNonce, err := list.GetLatestNonce()
-	if err != nil {
-		return 0, err
+	return expectedNonce, nil
+}
+
+// SimulateTransaction validates txn against a scratch copy of the pool state
+// and returns the changes it would make to the ledger. If txn has the nonce of
+// a pending txn of its sender, it is simulated as the replacement of that
+// txn. The pool is not changed.
+func (tp *TxnPool) SimulateTransaction(txn *transaction.Transaction) *chain.TxnSimulation {
+	var replaced []*transaction.Transaction
+	if sender, err := txn.GetProgramHashes(); err == nil {
+		if v, ok := tp.TxLists.Load(sender[0]); ok {
+			if old, err := v.(*NonceSortedTxs).Get(txn.UnsignedTx.Nonce); err == nil {
+				replaced = append(replaced, old)
+			}
+		}
 	}
-	expectedNonce := pendingNonce + 1
 
-	return expectedNonce, nil
+	tp.blockValidationState.Lock()
+	bvs := tp.blockValidationState.Copy()
+	tp.blockValidationState.Unlock()
+
+	if err := bvs.CleanSubmittedTransactions(replaced); err != nil {
+		log.Warningf("Remove replaced txn from simulation state error: %v", err)
+	}
+
+	return chain.SimulateTransaction(txn, bvs)
+}
+
+// MinReplacementFee returns the lowest fee a txn needs to replace a pending
+// txn with the same nonce and the given fee. The fee has to be raised by at
+// least bump percent, and always by at least one unit.
+func MinReplacementFee(fee int64, bump uint32) int64 {
+	b := int64(bump)
+	minFee := fee + fee/100*b + (fee%100*b+99)/100
+	if minFee <= fee {
+		minFee = fee + 1
+	}
+	return minFee
 }
 
 func shortHashToKey(shortHash []byte) string {
@@ -0,0 +1,337 @@
+package pool
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/chain/db"
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/program"
+	"github.com/nknorg/nkn/signature"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/vault"
+)
+
+const testFunds = 1000 * StorageFactor
+
+// newTestLedger sets chain.DefaultLedger to a fresh ledger in memory whose
+// block at height 1 pays testFunds to each of accounts.
+func newTestLedger(t *testing.T, accounts ...*vault.Account) *db.ChainStore {
+	backend, proposer := config.Parameters.ChainDBBackend, config.Parameters.GenesisBlockProposer
+	defer func() {
+		config.Parameters.ChainDBBackend, config.Parameters.GenesisBlockProposer = backend, proposer
+	}()
+	config.Parameters.ChainDBBackend = db.MemoryBackend
+	config.Parameters.GenesisBlockProposer = "a0309f8280ca86687a30ca86556113a253762e40eb884fc6063cad2b1ebd7de5"
+
+	store, err := db.NewLedgerStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	blockchain, err := chain.NewBlockchainWithGenesisBlock(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.DefaultLedger = &chain.Ledger{Blockchain: blockchain, Store: store}
+
+	if len(accounts) == 0 {
+		return store
+	}
+
+	donation, err := ToScriptHash(config.DonationAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txns := make([]*transaction.Transaction, 0, len(accounts))
+	for i, account := range accounts {
+		pl, err := transaction.Pack(pb.COINBASE_TYPE, transaction.NewCoinbase(donation, account.ProgramHash, testFunds))
+		if err != nil {
+			t.Fatal(err)
+		}
+		txn := &transaction.Transaction{Transaction: transaction.NewMsgTx(pl, uint64(i), 0, []byte{byte(i)})}
+		txn.Programs = []*pb.Program{{Code: []byte{0x00}, Parameter: []byte{0x00}}}
+		txns = append(txns, txn)
+	}
+
+	genesis, err := store.GetBlockByHeight(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prevHash := genesis.Hash()
+	b := &block.Block{
+		Header: &block.Header{
+			Header: &pb.Header{
+				UnsignedHeader: &pb.UnsignedHeader{
+					Version:       config.HeaderVersion,
+					PrevBlockHash: prevHash.ToArray(),
+					Timestamp:     genesis.Header.UnsignedHeader.Timestamp + 1,
+					Height:        1,
+				},
+			},
+		},
+		Transactions: txns,
+	}
+	root, err := store.GenerateStateRoot(b, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Header.UnsignedHeader.StateRoot = root.ToArray()
+	if err := b.RebuildMerkleRoot(); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SaveBlock(b, true); err != nil {
+		t.Fatal(err)
+	}
+
+	return store
+}
+
+func newTestAccount(t *testing.T) *vault.Account {
+	account, err := vault.NewAccount()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return account
+}
+
+// newTestTransfer returns a txn from account to itself signed by account.
+func newTestTransfer(t *testing.T, account *vault.Account, nonce uint64, fee Fixed64) *transaction.Transaction {
+	txn, err := transaction.NewTransferAssetTransaction(account.ProgramHash, account.ProgramHash, config.NKNAssetID, nonce, 1, fee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signTestTxn(t, txn, account)
+	return txn
+}
+
+func signTestTxn(t *testing.T, txn *transaction.Transaction, account *vault.Account) {
+	ct, err := program.CreateSignatureProgramContext(account.PubKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := signature.SignBySigner(txn, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txn.SetPrograms([]*pb.Program{ct.NewProgram(sig)})
+}
+
+// pendingCount returns the number of txns of sender that are not queued in
+// the future queue.
+func pendingCount(tp *TxnPool, sender Uint160) int {
+	if v, ok := tp.TxLists.Load(sender); ok {
+		return v.(*NonceSortedTxs).Len()
+	}
+	return 0
+}
+
+func TestGetNonceByTxnPool(t *testing.T) {
+	tests := []struct {
+		name    string
+		pending []uint64
+		future  []uint64
+		want    uint64
+		wantErr bool
+	}{
+		{name: "empty", wantErr: true},
+		{name: "pending only", pending: []uint64{0, 1}, want: 2},
+		{name: "contiguous future", pending: []uint64{0}, future: []uint64{1, 2}, want: 3},
+		{name: "gap after pending", pending: []uint64{0}, future: []uint64{2, 3}, want: 1},
+		{name: "gap in future", pending: []uint64{0}, future: []uint64{1, 3}, want: 2},
+		{name: "future only", future: []uint64{0, 1, 4}, want: 2},
+		{name: "future only with gap", future: []uint64{2}, want: 0},
+	}
+
+	account := newTestAccount(t)
+	newTestLedger(t, account)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp := NewTxPool()
+			for _, nonce := range tt.pending {
+				list, err := tp.getOrNewList(account.ProgramHash)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := list.Push(newTestTransfer(t, account, nonce, 0)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, nonce := range tt.future {
+				if err := tp.addFutureTxn(account.ProgramHash, 0, newTestTransfer(t, account, nonce, 0)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			nonce, err := tp.GetNonceByTxnPool(account.ProgramHash)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got nonce %d, want error", nonce)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if nonce != tt.want {
+				t.Fatalf("got nonce %d, want %d", nonce, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinReplacementFee(t *testing.T) {
+	tests := []struct {
+		fee  int64
+		bump uint32
+		want int64
+	}{
+		{fee: 0, bump: 10, want: 1},
+		{fee: 5, bump: 10, want: 6},
+		{fee: 100, bump: 10, want: 110},
+		{fee: 101, bump: 10, want: 112},
+		{fee: 99, bump: 25, want: 124},
+		{fee: 1000, bump: 0, want: 1001},
+		{fee: 1000, bump: 100, want: 2000},
+		{fee: 1 << 60, bump: 10, want: 1<<60 + (1<<60)/10 + 1},
+	}
+
+	for _, tt := range tests {
+		if got := MinReplacementFee(tt.fee, tt.bump); got != tt.want {
+			t.Errorf("MinReplacementFee(%d, %d) = %d, want %d", tt.fee, tt.bump, got, tt.want)
+		}
+	}
+}
+
+func TestSimulateTransaction(t *testing.T) {
+	sender, recipient := newTestAccount(t), newTestAccount(t)
+	registrant := sender.PubKey().EncodePoint()
+
+	tests := []struct {
+		name     string
+		pending  []*transaction.Transaction
+		txn      func() (*transaction.Transaction, error)
+		nonce    uint64
+		balances map[Uint160]Fixed64
+		names    []*chain.NameChange
+		subs     []*chain.SubscriptionChange
+		wantErr  bool
+	}{
+		{
+			name: "transfer",
+			txn: func() (*transaction.Transaction, error) {
+				return transaction.NewTransferAssetTransaction(sender.ProgramHash, recipient.ProgramHash, config.NKNAssetID, 0, 100, 3)
+			},
+			nonce:    1,
+			balances: map[Uint160]Fixed64{sender.ProgramHash: -103, recipient.ProgramHash: 100},
+		},
+		{
+			name:    "after pending",
+			pending: []*transaction.Transaction{newTestTransfer(t, sender, 0, 0)},
+			txn: func() (*transaction.Transaction, error) {
+				return transaction.NewTransferAssetTransaction(sender.ProgramHash, recipient.ProgramHash, config.NKNAssetID, 1, 100, 3)
+			},
+			nonce:    2,
+			balances: map[Uint160]Fixed64{sender.ProgramHash: -103, recipient.ProgramHash: 100},
+		},
+		{
+			name:    "replacement",
+			pending: []*transaction.Transaction{newTestTransfer(t, sender, 0, 10)},
+			txn: func() (*transaction.Transaction, error) {
+				return transaction.NewTransferAssetTransaction(sender.ProgramHash, recipient.ProgramHash, config.NKNAssetID, 0, 100, 20)
+			},
+			nonce:    1,
+			balances: map[Uint160]Fixed64{sender.ProgramHash: -120, recipient.ProgramHash: 100},
+		},
+		{
+			name: "insufficient balance",
+			txn: func() (*transaction.Transaction, error) {
+				return transaction.NewTransferAssetTransaction(sender.ProgramHash, recipient.ProgramHash, config.NKNAssetID, 0, testFunds, 1)
+			},
+			wantErr: true,
+		},
+		{
+			name: "register name",
+			txn: func() (*transaction.Transaction, error) {
+				return transaction.NewRegisterNameTransaction(registrant, "foobar", 0, 5)
+			},
+			nonce:    1,
+			balances: map[Uint160]Fixed64{sender.ProgramHash: -5},
+			names: []*chain.NameChange{{
+				Action:     chain.NameChangeRegister,
+				Name:       "foobar",
+				Registrant: registrant,
+				Expiration: 2 + config.NameRegistrationDuration,
+			}},
+		},
+		{
+			name: "subscribe",
+			txn: func() (*transaction.Transaction, error) {
+				return transaction.NewSubscribeTransaction(registrant, "id", "topic", 0, 100, "meta", 0, 5)
+			},
+			nonce:    1,
+			balances: map[Uint160]Fixed64{sender.ProgramHash: -5},
+			subs: []*chain.SubscriptionChange{{
+				Topic:      "topic",
+				Subscriber: registrant,
+				Identifier: "id",
+				Meta:       "meta",
+				Expiration: 2 + 100,
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestLedger(t, sender, recipient)
+			tp := NewTxPool()
+			for _, txn := range tt.pending {
+				if err := tp.AppendTxnPool(txn); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			txn, err := tt.txn()
+			if err != nil {
+				t.Fatal(err)
+			}
+			signTestTxn(t, txn, sender)
+
+			sim := tp.SimulateTransaction(txn)
+			if tt.wantErr {
+				if sim.Err == nil {
+					t.Fatal("got no simulation error, want one")
+				}
+				return
+			}
+			if sim.Err != nil {
+				t.Fatal(sim.Err)
+			}
+
+			if sim.NewNonce != tt.nonce {
+				t.Fatalf("got new nonce %d, want %d", sim.NewNonce, tt.nonce)
+			}
+			if len(sim.BalanceChanges) != len(tt.balances) {
+				t.Fatalf("got %d balance changes, want %d", len(sim.BalanceChanges), len(tt.balances))
+			}
+			for _, c := range sim.BalanceChanges {
+				if c.AssetID != config.NKNAssetID || c.Amount != tt.balances[c.Address] {
+					t.Fatalf("got change %v of %v, want %v", c.Amount, c.Address, tt.balances[c.Address])
+				}
+			}
+			if !reflect.DeepEqual(sim.NameChanges, append([]*chain.NameChange{}, tt.names...)) {
+				t.Fatalf("got name changes %+v, want %+v", sim.NameChanges, tt.names)
+			}
+			if !reflect.DeepEqual(sim.SubscriptionChanges, append([]*chain.SubscriptionChange{}, tt.subs...)) {
+				t.Fatalf("got subscription changes %+v, want %+v", sim.SubscriptionChanges, tt.subs)
+			}
+
+			if n := pendingCount(tp, sender.ProgramHash); n != len(tt.pending) {
+				t.Fatalf("got %d pending txns after simulation, want %d", n, len(tt.pending))
+			}
+		})
+	}
+}
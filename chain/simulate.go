@@ -0,0 +1,95 @@
+package chain
+
+import (
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/transaction"
+)
+
+const (
+	NameChangeRegister = "register"
+	NameChangeTransfer = "transfer"
+	NameChangeDelete   = "delete"
+)
+
+// BalanceChange is the change of the balance of an asset held by an address.
+type BalanceChange struct {
+	Address Uint160
+	AssetID Uint256
+	Amount  Fixed64 // negative if the balance decreases
+}
+
+// NameChange is a name registered, transferred or deleted by a txn.
+type NameChange struct {
+	Action     string
+	Name       string
+	Registrant []byte
+	Recipient  []byte // new registrant, for NameChangeTransfer
+	Expiration uint32 // for NameChangeRegister
+}
+
+// SubscriptionChange is a subscription created or renewed by a txn.
+type SubscriptionChange struct {
+	Topic      string
+	Bucket     uint32
+	Subscriber []byte
+	Identifier string
+	Meta       string
+	Expiration uint32
+}
+
+// TxnSimulation is the result of SimulateTransaction. Err is the validation
+// error of the txn, the changes are what the txn would do to the ledger if it
+// was included in the next block.
+type TxnSimulation struct {
+	Hash                Uint256
+	Sender              Uint160
+	Signed              bool
+	Nonce               uint64 // ledger nonce of sender
+	NewNonce            uint64 // nonce of sender after txn and the pending ones before it
+	BalanceChanges      []*BalanceChange
+	NameChanges         []*NameChange
+	SubscriptionChanges []*SubscriptionChange
+	Err                 error
+}
+
+// SimulateTransaction validates txn the same way the txn pool does and
+// computes its changes to the ledger, without changing the ledger or bvs. bvs
+// should be a scratch copy of the state of pending txns, see
+// BlockValidationState.Copy. The signature of txn is only checked if it has
+// programs, so unsigned txns can be simulated too.
+func SimulateTransaction(txn *transaction.Transaction, bvs *BlockValidationState) *TxnSimulation {
+	sim := &TxnSimulation{
+		Hash:   txn.Hash(),
+		Signed: len(txn.Programs) > 0,
+	}
+
+	hashes, err := txn.GetProgramHashes()
+	if err != nil {
+		sim.Err = err
+		return sim
+	}
+	sim.Sender = hashes[0]
+	sim.Nonce = DefaultLedger.Store.GetNonce(sim.Sender)
+	sim.NewNonce = sim.Nonce
+
+	if err := verifyTransaction(txn, sim.Signed); err != nil {
+		sim.Err = err
+		return sim
+	}
+	if err := VerifyTransactionWithLedger(txn); err != nil {
+		sim.Err = err
+		return sim
+	}
+	if err := bvs.VerifyTransactionWithBlock(txn, nil); err != nil {
+		bvs.Reset()
+		sim.Err = err
+		return sim
+	}
+	bvs.Reset()
+
+	if err := DefaultLedger.Store.ComputeTransactionChanges(txn, DefaultLedger.Store.GetHeight()+1, sim); err != nil {
+		sim.Err = err
+	}
+
+	return sim
+}
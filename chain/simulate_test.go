@@ -0,0 +1,24 @@
+package chain
+
+import (
+	"testing"
+
+	. "github.com/nknorg/nkn/common"
+)
+
+func TestBlockValidationStateCopy(t *testing.T) {
+	bvs := NewBlockValidationState()
+	bvs.totalAmount[Uint160{1}] = 10
+	bvs.registeredNames["foo"] = struct{}{}
+
+	c := bvs.Copy()
+	c.totalAmount[Uint160{1}] = 20
+	delete(c.registeredNames, "foo")
+
+	if bvs.totalAmount[Uint160{1}] != 10 {
+		t.Fatal("changing copy changed total amount of original state")
+	}
+	if _, ok := bvs.registeredNames["foo"]; !ok {
+		t.Fatal("changing copy changed registered names of original state")
+	}
+}
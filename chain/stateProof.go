@@ -0,0 +1,26 @@
+package chain
+
+import (
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/chain/trie"
+	. "github.com/nknorg/nkn/common"
+)
+
+// StateProof is a merkle proof of a state trie entry against the state root
+// of a block header.
+type StateProof struct {
+	Header *block.Header
+	Key    []byte
+	Value  []byte
+	Proof  [][]byte
+}
+
+// Verify checks the proof against the state root in the header and returns
+// the proven value, which is nil if the key is absent from the state.
+func (sp *StateProof) Verify() ([]byte, error) {
+	root, err := Uint256ParseFromBytes(sp.Header.UnsignedHeader.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+	return trie.VerifyProof(root, sp.Key, sp.Proof)
+}
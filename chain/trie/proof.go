@@ -0,0 +1,132 @@
+package trie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/nknorg/nkn/common"
+)
+
+// Prove constructs a merkle proof for key. The result contains the encoded
+// nodes on the path from the root to the value at key, root first. Nodes that
+// are embedded in their parent are not included separately. If the key does
+// not exist in the trie, the proof contains the nodes proving its absence.
+func (t *Trie) Prove(key []byte) ([][]byte, error) {
+	key = keyBytesToHex(key)
+	nodes := make([]node, 0)
+	tn := t.root
+	for len(key) > 0 && tn != nil {
+		switch n := tn.(type) {
+		case *shortNode:
+			if len(key) < len(n.Key) || !bytes.Equal(n.Key, key[:len(n.Key)]) {
+				tn = nil
+			} else {
+				tn = n.Val
+				key = key[len(n.Key):]
+			}
+			nodes = append(nodes, n)
+		case *fullNode:
+			tn = n.Children[key[0]]
+			key = key[1:]
+			nodes = append(nodes, n)
+		case hashNode:
+			var err error
+			tn, err = t.resolveHash(n)
+			if err != nil {
+				return nil, err
+			}
+		case valueNode:
+			tn = nil
+		default:
+			panic(fmt.Sprintf("invalid prove node type: %v", tn))
+		}
+	}
+
+	h := newHasher()
+	defer returnHasherToPool(h)
+
+	proof := make([][]byte, 0, len(nodes))
+	for i, n := range nodes {
+		collapsed, _, err := h.hasChildren(n, nil)
+		if err != nil {
+			return nil, err
+		}
+		hashed, err := h.store(collapsed, nil, i == 0)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := hashed.(hashNode); !ok {
+			continue
+		}
+		buff := bytes.NewBuffer(nil)
+		if err := collapsed.Serialize(buff); err != nil {
+			return nil, err
+		}
+		proof = append(proof, buff.Bytes())
+	}
+
+	return proof, nil
+}
+
+// VerifyProof checks a merkle proof generated by Prove against rootHash and
+// returns the value of key. A nil value with nil error means the proof shows
+// that key is absent from the trie.
+func VerifyProof(rootHash common.Uint256, key []byte, proof [][]byte) ([]byte, error) {
+	nodes := make(map[string][]byte, len(proof))
+	for _, enc := range proof {
+		nodes[string(hash256(enc))] = enc
+	}
+
+	key = keyBytesToHex(key)
+	wantHash := rootHash.ToArray()
+	for i := 0; ; i++ {
+		buf, ok := nodes[string(wantHash)]
+		if !ok {
+			return nil, fmt.Errorf("proof node %d (hash %x) missing", i, wantHash)
+		}
+		n, err := decodeNode(wantHash, buf)
+		if err != nil {
+			return nil, fmt.Errorf("bad proof node %d: %v", i, err)
+		}
+		keyrest, cld := getProofChild(n, key)
+		switch cld := cld.(type) {
+		case nil:
+			return nil, nil
+		case hashNode:
+			key = keyrest
+			wantHash = cld
+		case valueNode:
+			return cld, nil
+		default:
+			return nil, errors.New("invalid proof node type")
+		}
+	}
+}
+
+func getProofChild(tn node, key []byte) ([]byte, node) {
+	for {
+		switch n := tn.(type) {
+		case *shortNode:
+			if len(key) < len(n.Key) || !bytes.Equal(n.Key, key[:len(n.Key)]) {
+				return nil, nil
+			}
+			tn = n.Val
+			key = key[len(n.Key):]
+		case *fullNode:
+			if len(key) == 0 {
+				return nil, nil
+			}
+			tn = n.Children[key[0]]
+			key = key[1:]
+		case hashNode:
+			return key, n
+		case nil:
+			return key, nil
+		case valueNode:
+			return nil, n
+		default:
+			panic(fmt.Sprintf("invalid proof node type: %v", tn))
+		}
+	}
+}
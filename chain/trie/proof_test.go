@@ -0,0 +1,70 @@
+package trie
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/nknorg/nkn/common"
+)
+
+func TestProof(t *testing.T) {
+	db := NewMemDatabase()
+	tr, _ := New(common.EmptyUint256, db)
+
+	values := make(map[string][]byte)
+	for i := 0; i < 200; i++ {
+		key := []byte(fmt.Sprintf("key-%d", i))
+		value := []byte(fmt.Sprintf("value-%d", i))
+		tr.Update(key, value)
+		values[string(key)] = value
+	}
+	tr.Update([]byte("k"), []byte("v"))
+	values["k"] = []byte("v")
+
+	root, err := tr.Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tr, err = New(root, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for k, v := range values {
+		proof, err := tr.Prove([]byte(k))
+		if err != nil {
+			t.Fatalf("prove %s: %v", k, err)
+		}
+		value, err := VerifyProof(root, []byte(k), proof)
+		if err != nil {
+			t.Fatalf("verify %s: %v", k, err)
+		}
+		if !bytes.Equal(value, v) {
+			t.Fatalf("verify %s: got %x, want %x", k, value, v)
+		}
+	}
+
+	proof, err := tr.Prove([]byte("missing"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := VerifyProof(root, []byte("missing"), proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != nil {
+		t.Fatalf("absent key proved value %x", value)
+	}
+
+	proof, _ = tr.Prove([]byte("key-1"))
+	if _, err := VerifyProof(root, []byte("key-1"), proof[1:]); err == nil {
+		t.Fatal("incomplete proof verified")
+	}
+	proof[len(proof)-1] = append([]byte{}, proof[len(proof)-1]...)
+	proof[len(proof)-1][len(proof[len(proof)-1])-1] ^= 0xff
+	if _, err := VerifyProof(root, []byte("key-1"), proof); err == nil {
+		t.Fatal("tampered proof verified")
+	}
+}
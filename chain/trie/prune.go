@@ -0,0 +1,54 @@
+package trie
+
+import (
+	"github.com/nknorg/nkn/common"
+)
+
+// NodeKeyPrefix returns the database key prefix trie nodes are stored under.
+func NodeKeyPrefix() []byte {
+	return append([]byte{}, secureKeyPrefix...)
+}
+
+// NodeKey returns the database key of the trie node with hash.
+func NodeKey(hash common.Uint256) []byte {
+	return append(NodeKeyPrefix(), hash[:]...)
+}
+
+// MarkReachable adds the hash of every stored node reachable from root to
+// marked. Subtrees whose root is already marked are not visited again.
+func MarkReachable(db Database, root common.Uint256, marked map[common.Uint256]struct{}) error {
+	if root == common.EmptyUint256 {
+		return nil
+	}
+	if _, ok := marked[root]; ok {
+		return nil
+	}
+
+	tr, err := New(root, db)
+	if err != nil {
+		return err
+	}
+
+	it := tr.NodeIterator(nil)
+	descend := true
+	for it.Next(descend) {
+		descend = true
+		hash := it.Hash()
+		if hash == common.EmptyUint256 {
+			continue
+		}
+		if _, ok := marked[hash]; ok {
+			descend = false
+			continue
+		}
+		marked[hash] = struct{}{}
+	}
+
+	return it.Error()
+}
+
+// NodeHash returns the hash of an encoded trie node.
+func NodeHash(enc []byte) common.Uint256 {
+	hash, _ := common.Uint256ParseFromBytes(hash256(enc))
+	return hash
+}
@@ -0,0 +1,50 @@
+package trie
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nknorg/nkn/common"
+)
+
+func TestMarkReachable(t *testing.T) {
+	db := NewMemDatabase()
+	tr, _ := New(common.EmptyUint256, db)
+	for i := 0; i < 100; i++ {
+		tr.Update([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", i)))
+	}
+	oldRoot, _ := tr.Commit()
+	tr.Update([]byte("key-1"), []byte("changed"))
+	newRoot, _ := tr.Commit()
+
+	marked := make(map[common.Uint256]struct{})
+	if err := MarkReachable(db, newRoot, marked); err != nil {
+		t.Fatal(err)
+	}
+
+	stale := 0
+	for key := range db.db {
+		hash, _ := common.Uint256ParseFromBytes([]byte(key[len(NodeKeyPrefix()):]))
+		if _, ok := marked[hash]; !ok {
+			stale++
+			delete(db.db, key)
+		}
+	}
+	if stale == 0 {
+		t.Fatal("no stale nodes found after update")
+	}
+
+	tr, err := New(newRoot, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		key := []byte(fmt.Sprintf("key-%d", i))
+		if _, err := tr.TryGet(key); err != nil {
+			t.Fatalf("get %s after pruning: %v", key, err)
+		}
+	}
+	if ok, _ := db.Has(NodeKey(oldRoot)); ok {
+		t.Fatal("pruned root is still available")
+	}
+}
@@ -21,10 +21,22 @@ var (
 	ErrIDRegistered           = errors.New("ID has be registered")
 	ErrDuplicateGenerateIDTxn = errors.New("[VerifyTransactionWithBlock], duplicate GenerateID txns")
 	ErrDuplicateIssueAssetTxn = errors.New("[VerifyTransactionWithBlock], duplicate IssueAsset txns")
+	ErrTxnExpired             = errors.New("txn has passed its valid until height")
 )
 
+// IsTxnExpired returns whether txn is no longer valid in a block at height.
+// Txns without a valid until height never expire.
+func IsTxnExpired(txn *transaction.Transaction, height uint32) bool {
+	validUntil := txn.UnsignedTx.ValidUntilHeight
+	return validUntil > 0 && height > validUntil
+}
+
 // VerifyTransaction verifys received single transaction
 func VerifyTransaction(txn *transaction.Transaction) error {
+	return verifyTransaction(txn, true)
+}
+
+func verifyTransaction(txn *transaction.Transaction, checkSignature bool) error {
 	if err := CheckTransactionSize(txn); err != nil {
 		return fmt.Errorf("[VerifyTransaction],%v\n", err)
 	}
@@ -41,8 +53,10 @@ func VerifyTransaction(txn *transaction.Transaction) error {
 		return fmt.Errorf("[VerifyTransaction],%v\n", err)
 	}
 
-	if err := txn.VerifySignature(); err != nil {
-		return fmt.Errorf("[VerifyTransaction],%v\n", err)
+	if checkSignature {
+		if err := txn.VerifySignature(); err != nil {
+			return fmt.Errorf("[VerifyTransaction],%v\n", err)
+		}
 	}
 
 	if err := CheckTransactionPayload(txn); err != nil {
@@ -90,6 +104,49 @@ func checkAmountPrecise(amount Fixed64, precision byte) bool {
 	return amount.GetData()%int64(math.Pow(10, 8-float64(precision))) != 0
 }
 
+// GetTransferAssetID returns the asset moved by a transfer payload. Payloads
+// without asset ID transfer NKN.
+func GetTransferAssetID(pld *pb.TransferAsset) (Uint256, error) {
+	if len(pld.AssetId) == 0 {
+		return config.NKNAssetID, nil
+	}
+
+	return Uint256ParseFromBytes(pld.AssetId)
+}
+
+// GetTransferOutputAssetID returns the asset moved by an output of a batch
+// transfer. Outputs without asset ID transfer NKN.
+func GetTransferOutputAssetID(output *pb.TransferOutput) (Uint256, error) {
+	if len(output.AssetId) == 0 {
+		return config.NKNAssetID, nil
+	}
+
+	return Uint256ParseFromBytes(output.AssetId)
+}
+
+// GetBatchTransferAmounts returns the total amount of each asset moved by a
+// batch transfer payload.
+func GetBatchTransferAmounts(pld *pb.BatchTransferAsset) (map[Uint256]Fixed64, error) {
+	amounts := make(map[Uint256]Fixed64)
+	for _, output := range pld.Outputs {
+		assetID, err := GetTransferOutputAssetID(output)
+		if err != nil {
+			return nil, err
+		}
+
+		amount := Fixed64(output.Amount)
+		if amount < 0 {
+			return nil, errors.New("transfer amount error.")
+		}
+		if amounts[assetID] > math.MaxInt64-amount {
+			return nil, errors.New("total transfer amount overflow")
+		}
+		amounts[assetID] += amount
+	}
+
+	return amounts, nil
+}
+
 func CheckTransactionPayload(txn *transaction.Transaction) error {
 	payload, err := transaction.Unpack(txn.UnsignedTx.Payload)
 	if err != nil {
@@ -122,6 +179,10 @@ func CheckTransactionPayload(txn *transaction.Transaction) error {
 			return errors.New("illegal transaction sender")
 		}
 
+		if len(pld.AssetId) != 0 && len(pld.AssetId) != UINT256SIZE {
+			return errors.New("length of asset id error")
+		}
+
 		if checkAmountPrecise(Fixed64(pld.Amount), 8) {
 			return errors.New("The precision of amount is incorrect.")
 		}
@@ -129,6 +190,44 @@ func CheckTransactionPayload(txn *transaction.Transaction) error {
 		if pld.Amount < 0 {
 			return errors.New("transfer amount error.")
 		}
+	case pb.BATCH_TRANSFER_ASSET_TYPE:
+		pld := payload.(*pb.BatchTransferAsset)
+		if len(pld.Sender) != UINT160SIZE {
+			return errors.New("length of programhash error")
+		}
+
+		donationProgramhash, _ := ToScriptHash(config.DonationAddress)
+		if bytes.Equal(pld.Sender, donationProgramhash[:]) {
+			return errors.New("illegal transaction sender")
+		}
+
+		if len(pld.Outputs) == 0 || len(pld.Outputs) > config.MaxBatchTransferOutputs {
+			return fmt.Errorf("batch transfer should have 1 to %d outputs", config.MaxBatchTransferOutputs)
+		}
+
+		for _, output := range pld.Outputs {
+			if len(output.Recipient) != UINT160SIZE {
+				return errors.New("length of programhash error")
+			}
+
+			if len(output.AssetId) != 0 && len(output.AssetId) != UINT256SIZE {
+				return errors.New("length of asset id error")
+			}
+
+			if checkAmountPrecise(Fixed64(output.Amount), 8) {
+				return errors.New("The precision of amount is incorrect.")
+			}
+		}
+
+		if _, err := GetBatchTransferAmounts(pld); err != nil {
+			return err
+		}
+
+		// batch transfers pay for their size, which grows with the outputs
+		minFee := config.MinBatchTransferFeePerByte * Fixed64(txn.GetSize())
+		if Fixed64(txn.UnsignedTx.Fee) < minFee {
+			return fmt.Errorf("batch transfer fee %s is lower than %s for %d bytes", Fixed64(txn.UnsignedTx.Fee).String(), minFee.String(), txn.GetSize())
+		}
 	case pb.SIG_CHAIN_TXN_TYPE:
 	case pb.REGISTER_NAME_TYPE:
 		pld := payload.(*pb.RegisterName)
@@ -139,6 +238,15 @@ func CheckTransactionPayload(txn *transaction.Transaction) error {
 		if !match {
 			return fmt.Errorf("name %s should start with a letter, contain A-Za-z0-9-_.+ and have length 3-255", pld.Name)
 		}
+	case pb.TRANSFER_NAME_TYPE:
+		pld := payload.(*pb.TransferName)
+		if _, err := crypto.NewPubKeyFromBytes(pld.Recipient); err != nil {
+			return fmt.Errorf("TransferName recipient error: %v", err)
+		}
+
+		if bytes.Equal(pld.Registrant, pld.Recipient) {
+			return errors.New("can not transfer name to its current registrant")
+		}
 	case pb.DELETE_NAME_TYPE:
 	case pb.SUBSCRIBE_TYPE:
 		pld := payload.(*pb.Subscribe)
@@ -244,17 +352,21 @@ func VerifyTransactionWithLedger(txn *transaction.Transaction) error {
 		return errors.New("[VerifyTransactionWithLedger] duplicate transaction check faild")
 	}
 
+	if IsTxnExpired(txn, DefaultLedger.Store.GetHeight()+1) {
+		return ErrTxnExpired
+	}
+
 	payload, err := transaction.Unpack(txn.UnsignedTx.Payload)
 	if err != nil {
 		return errors.New("Unpack transactiion's payload error")
 	}
 
 	checkNonce := func() error {
-		sender, err := ToCodeHash(txn.Programs[0].Code)
+		sender, err := txn.GetProgramHashes()
 		if err != nil {
 			return err
 		}
-		nonce := DefaultLedger.Store.GetNonce(sender)
+		nonce := DefaultLedger.Store.GetNonce(sender[0])
 
 		if txn.UnsignedTx.Nonce < nonce {
 			return errors.New("nonce is too low")
@@ -281,10 +393,58 @@ func VerifyTransactionWithLedger(txn *transaction.Transaction) error {
 		}
 
 		pld := payload.(*pb.TransferAsset)
-		balance := DefaultLedger.Store.GetBalance(BytesToUint160(pld.Sender))
+		assetID, err := GetTransferAssetID(pld)
+		if err != nil {
+			return err
+		}
+
+		_, _, _, precision, err := DefaultLedger.Store.GetAsset(assetID)
+		if err != nil {
+			return fmt.Errorf("asset %s not found: %v", assetID.ToHexString(), err)
+		}
+
+		if checkAmountPrecise(Fixed64(pld.Amount), byte(precision)) {
+			return fmt.Errorf("The precision of amount is incorrect, asset %s has precision %d.", assetID.ToHexString(), precision)
+		}
+
+		balance := DefaultLedger.Store.GetBalanceByAssetID(BytesToUint160(pld.Sender), assetID)
 		if int64(balance) < pld.Amount {
 			return errors.New("not sufficient funds")
 		}
+	case pb.BATCH_TRANSFER_ASSET_TYPE:
+		if err := checkNonce(); err != nil {
+			return err
+		}
+
+		pld := payload.(*pb.BatchTransferAsset)
+		amounts, err := GetBatchTransferAmounts(pld)
+		if err != nil {
+			return err
+		}
+
+		precisions := make(map[Uint256]uint32, len(amounts))
+		for assetID, amount := range amounts {
+			_, _, _, precision, err := DefaultLedger.Store.GetAsset(assetID)
+			if err != nil {
+				return fmt.Errorf("asset %s not found: %v", assetID.ToHexString(), err)
+			}
+			precisions[assetID] = precision
+
+			balance := DefaultLedger.Store.GetBalanceByAssetID(BytesToUint160(pld.Sender), assetID)
+			if balance < amount {
+				return fmt.Errorf("not sufficient funds of asset %s", assetID.ToHexString())
+			}
+		}
+
+		for _, output := range pld.Outputs {
+			assetID, err := GetTransferOutputAssetID(output)
+			if err != nil {
+				return err
+			}
+			if checkAmountPrecise(Fixed64(output.Amount), byte(precisions[assetID])) {
+				return fmt.Errorf("The precision of amount is incorrect, asset %s has precision %d.", assetID.ToHexString(), precisions[assetID])
+			}
+		}
 	case pb.SIG_CHAIN_TXN_TYPE:
 	case pb.REGISTER_NAME_TYPE:
 		if err := checkNonce(); err != nil {
@@ -292,17 +452,43 @@ func VerifyTransactionWithLedger(txn *transaction.Transaction) error {
 		}
 
 		pld := payload.(*pb.RegisterName)
-		name, err := DefaultLedger.Store.GetName(pld.Registrant)
-		if name != "" {
-			return fmt.Errorf("pubKey %+v already has registered name %s", pld.Registrant, name)
-		}
+		registrant, err := DefaultLedger.Store.GetRegistrant(pld.Name)
 		if err != nil {
 			return err
 		}
+		if registrant != nil && !bytes.Equal(registrant, pld.Registrant) {
+			return fmt.Errorf("name %s is already registered for pubKey %+v", pld.Name, registrant)
+		}
+
+		// registering the name owned by the registrant renews it
+		if registrant == nil {
+			name, err := DefaultLedger.Store.GetName(pld.Registrant)
+			if name != "" {
+				return fmt.Errorf("pubKey %+v already has registered name %s", pld.Registrant, name)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	case pb.TRANSFER_NAME_TYPE:
+		if err := checkNonce(); err != nil {
+			return err
+		}
 
+		pld := payload.(*pb.TransferName)
 		registrant, err := DefaultLedger.Store.GetRegistrant(pld.Name)
-		if registrant != nil {
-			return fmt.Errorf("name %s is already registered for pubKey %+v", pld.Name, registrant)
+		if err != nil {
+			return err
+		}
+		if registrant == nil {
+			return fmt.Errorf("name %s is not registered", pld.Name)
+		} else if !bytes.Equal(registrant, pld.Registrant) {
+			return fmt.Errorf("name %s is not registered for pubKey %+v", pld.Name, pld.Registrant)
+		}
+
+		name, err := DefaultLedger.Store.GetName(pld.Recipient)
+		if name != "" {
+			return fmt.Errorf("pubKey %+v already has registered name %s", pld.Recipient, name)
 		}
 		if err != nil {
 			return err
@@ -411,10 +597,16 @@ type nanoPay struct {
 	nonce     uint64
 }
 
+type assetHolder struct {
+	assetID Uint256
+	holder  Uint160
+}
+
 type BlockValidationState struct {
 	sync.Mutex
 	txnlist           map[Uint256]struct{}
 	totalAmount       map[Uint160]Fixed64
+	totalAssetAmount  map[assetHolder]Fixed64
 	registeredNames   map[string]struct{}
 	nameRegistrants   map[string]struct{}
 	generateIDs       map[string]struct{}
@@ -434,6 +626,7 @@ func NewBlockValidationState() *BlockValidationState {
 func (bvs *BlockValidationState) initBlockValidationState() {
 	bvs.txnlist = make(map[Uint256]struct{}, 0)
 	bvs.totalAmount = make(map[Uint160]Fixed64, 0)
+	bvs.totalAssetAmount = make(map[assetHolder]Fixed64, 0)
 	bvs.registeredNames = make(map[string]struct{}, 0)
 	bvs.nameRegistrants = make(map[string]struct{}, 0)
 	bvs.generateIDs = make(map[string]struct{}, 0)
@@ -442,6 +635,40 @@ func (bvs *BlockValidationState) initBlockValidationState() {
 	bvs.nanoPays = make(map[nanoPay]struct{}, 0)
 }
 
+// Copy returns a copy of the committed state that can be changed without
+// affecting bvs. The caller should hold the lock of bvs.
+func (bvs *BlockValidationState) Copy() *BlockValidationState {
+	c := NewBlockValidationState()
+	for k, v := range bvs.txnlist {
+		c.txnlist[k] = v
+	}
+	for k, v := range bvs.totalAmount {
+		c.totalAmount[k] = v
+	}
+	for k, v := range bvs.totalAssetAmount {
+		c.totalAssetAmount[k] = v
+	}
+	for k, v := range bvs.registeredNames {
+		c.registeredNames[k] = v
+	}
+	for k, v := range bvs.nameRegistrants {
+		c.nameRegistrants[k] = v
+	}
+	for k, v := range bvs.generateIDs {
+		c.generateIDs[k] = v
+	}
+	for k, v := range bvs.subscriptions {
+		c.subscriptions[k] = v
+	}
+	for k, v := range bvs.subscriptionCount {
+		c.subscriptionCount[k] = v
+	}
+	for k, v := range bvs.nanoPays {
+		c.nanoPays[k] = v
+	}
+	return c
+}
+
 func (bvs *BlockValidationState) addChange(change func()) {
 	bvs.changes = append(bvs.changes, change)
 }
@@ -472,6 +699,11 @@ func (bvs *BlockValidationState) VerifyTransactionWithBlock(txn *transaction.Tra
 		}()
 	}
 
+	//2.check valid until height
+	if header != nil && IsTxnExpired(txn, header.UnsignedHeader.Height) {
+		return ErrTxnExpired
+	}
+
 	//3.check issue amount
 	payload, err := transaction.Unpack(txn.UnsignedTx.Payload)
 	if err != nil {
@@ -500,7 +732,67 @@ func (bvs *BlockValidationState) VerifyTransactionWithBlock(txn *transaction.Tra
 		}
 	case pb.TRANSFER_ASSET_TYPE:
 		transfer := payload.(*pb.TransferAsset)
-		amount = Fixed64(transfer.Amount)
+		assetID, err := GetTransferAssetID(transfer)
+		if err != nil {
+			return err
+		}
+
+		if assetID == config.NKNAssetID {
+			amount = Fixed64(transfer.Amount)
+			break
+		}
+
+		assetAmount := Fixed64(transfer.Amount)
+		if assetAmount > 0 {
+			key := assetHolder{assetID, sender}
+			assetBalance := DefaultLedger.Store.GetBalanceByAssetID(sender, assetID)
+			totalAssetAmount := bvs.totalAssetAmount[key]
+			if assetBalance < totalAssetAmount+assetAmount {
+				return errors.New("[VerifyTransactionWithBlock], not sufficient asset funds.")
+			}
+
+			defer func() {
+				if e == nil {
+					bvs.addChange(func() {
+						bvs.totalAssetAmount[key] = totalAssetAmount + assetAmount
+					})
+				}
+			}()
+		}
+	case pb.BATCH_TRANSFER_ASSET_TYPE:
+		amounts, err := GetBatchTransferAmounts(payload.(*pb.BatchTransferAsset))
+		if err != nil {
+			return err
+		}
+
+		totalAssetAmounts := make(map[assetHolder]Fixed64)
+		for assetID, assetAmount := range amounts {
+			if assetID == config.NKNAssetID {
+				amount = assetAmount
+				continue
+			}
+			if assetAmount == 0 {
+				continue
+			}
+
+			key := assetHolder{assetID, sender}
+			assetBalance := DefaultLedger.Store.GetBalanceByAssetID(sender, assetID)
+			totalAssetAmount := bvs.totalAssetAmount[key]
+			if assetBalance < totalAssetAmount+assetAmount {
+				return errors.New("[VerifyTransactionWithBlock], not sufficient asset funds.")
+			}
+			totalAssetAmounts[key] = totalAssetAmount + assetAmount
+		}
+
+		defer func() {
+			if e == nil {
+				bvs.addChange(func() {
+					for key, totalAssetAmount := range totalAssetAmounts {
+						bvs.totalAssetAmount[key] = totalAssetAmount
+					}
+				})
+			}
+		}()
 	case pb.REGISTER_NAME_TYPE:
 		namePayload := payload.(*pb.RegisterName)
 
@@ -522,6 +814,33 @@ func (bvs *BlockValidationState) VerifyTransactionWithBlock(txn *transaction.Tra
 				})
 			}
 		}()
+	case pb.TRANSFER_NAME_TYPE:
+		namePayload := payload.(*pb.TransferName)
+
+		name := namePayload.Name
+		if _, ok := bvs.registeredNames[name]; ok {
+			return errors.New("[VerifyTransactionWithBlock], duplicate name exist in block.")
+		}
+
+		registrant := BytesToHexString(namePayload.Registrant)
+		if _, ok := bvs.nameRegistrants[registrant]; ok {
+			return errors.New("[VerifyTransactionWithBlock], duplicate registrant exist in block.")
+		}
+
+		recipient := BytesToHexString(namePayload.Recipient)
+		if _, ok := bvs.nameRegistrants[recipient]; ok {
+			return errors.New("[VerifyTransactionWithBlock], duplicate registrant exist in block.")
+		}
+
+		defer func() {
+			if e == nil {
+				bvs.addChange(func() {
+					bvs.registeredNames[name] = struct{}{}
+					bvs.nameRegistrants[registrant] = struct{}{}
+					bvs.nameRegistrants[recipient] = struct{}{}
+				})
+			}
+		}()
 	case pb.DELETE_NAME_TYPE:
 		namePayload := payload.(*pb.DeleteName)
 
@@ -656,7 +975,55 @@ func (bvs *BlockValidationState) CleanSubmittedTransactions(txns []*transaction.
 		switch txn.UnsignedTx.Payload.Type {
 		case pb.TRANSFER_ASSET_TYPE:
 			transfer := payload.(*pb.TransferAsset)
-			amount = Fixed64(transfer.Amount)
+			assetID, err := GetTransferAssetID(transfer)
+			if err != nil {
+				return err
+			}
+
+			if assetID == config.NKNAssetID {
+				amount = Fixed64(transfer.Amount)
+				break
+			}
+
+			assetAmount := Fixed64(transfer.Amount)
+			if assetAmount > 0 {
+				key := assetHolder{assetID, sender}
+				if totalAssetAmount, ok := bvs.totalAssetAmount[key]; ok && totalAssetAmount >= assetAmount {
+					bvs.totalAssetAmount[key] -= assetAmount
+
+					if bvs.totalAssetAmount[key] == 0 {
+						delete(bvs.totalAssetAmount, key)
+					}
+				} else {
+					return errors.New("[CleanSubmittedTransactions], inconsistent block validation state.")
+				}
+			}
+		case pb.BATCH_TRANSFER_ASSET_TYPE:
+			amounts, err := GetBatchTransferAmounts(payload.(*pb.BatchTransferAsset))
+			if err != nil {
+				return err
+			}
+
+			for assetID, assetAmount := range amounts {
+				if assetID == config.NKNAssetID {
+					amount = assetAmount
+					continue
+				}
+				if assetAmount == 0 {
+					continue
+				}
+
+				key := assetHolder{assetID, sender}
+				if totalAssetAmount, ok := bvs.totalAssetAmount[key]; ok && totalAssetAmount >= assetAmount {
+					bvs.totalAssetAmount[key] -= assetAmount
+
+					if bvs.totalAssetAmount[key] == 0 {
+						delete(bvs.totalAssetAmount, key)
+					}
+				} else {
+					return errors.New("[CleanSubmittedTransactions], inconsistent block validation state.")
+				}
+			}
 		case pb.REGISTER_NAME_TYPE:
 			namePayload := payload.(*pb.RegisterName)
 
@@ -665,6 +1032,17 @@ func (bvs *BlockValidationState) CleanSubmittedTransactions(txns []*transaction.
 
 			registrant := BytesToHexString(namePayload.Registrant)
 			delete(bvs.nameRegistrants, registrant)
+		case pb.TRANSFER_NAME_TYPE:
+			namePayload := payload.(*pb.TransferName)
+
+			name := namePayload.Name
+			delete(bvs.registeredNames, name)
+
+			registrant := BytesToHexString(namePayload.Registrant)
+			delete(bvs.nameRegistrants, registrant)
+
+			recipient := BytesToHexString(namePayload.Recipient)
+			delete(bvs.nameRegistrants, recipient)
 		case pb.DELETE_NAME_TYPE:
 			namePayload := payload.(*pb.DeleteName)
 
@@ -0,0 +1,68 @@
+package chain
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+)
+
+func TestGetBatchTransferAmounts(t *testing.T) {
+	assetID := Uint256{1}
+	recipient := Uint160{4}
+	pld := &pb.BatchTransferAsset{
+		Outputs: []*pb.TransferOutput{
+			transaction.NewTransferOutput(Uint160{2}, config.NKNAssetID, 10),
+			transaction.NewTransferOutput(Uint160{3}, assetID, 5),
+			{Recipient: recipient.ToArray(), Amount: 20},
+		},
+	}
+
+	amounts, err := GetBatchTransferAmounts(pld)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amounts[config.NKNAssetID] != 30 || amounts[assetID] != 5 || len(amounts) != 2 {
+		t.Fatalf("got amounts %v, want 30 NKN and 5 of asset", amounts)
+	}
+
+	pld.Outputs = append(pld.Outputs, &pb.TransferOutput{Recipient: recipient.ToArray(), Amount: math.MaxInt64})
+	if _, err := GetBatchTransferAmounts(pld); err == nil {
+		t.Fatal("expect overflow error")
+	}
+
+	pld.Outputs = []*pb.TransferOutput{{Recipient: recipient.ToArray(), Amount: -1}}
+	if _, err := GetBatchTransferAmounts(pld); err == nil {
+		t.Fatal("expect negative amount error")
+	}
+}
+
+func TestValidUntilHeight(t *testing.T) {
+	txn, err := transaction.NewTransferAssetTransaction(Uint160{1}, Uint160{2}, config.NKNAssetID, 0, 10, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := txn.Hash()
+
+	txn.SetValidUntilHeight(0)
+	if txn.Hash() != hash {
+		t.Fatal("txn without valid until height changed hash")
+	}
+	if IsTxnExpired(txn, math.MaxUint32) {
+		t.Fatal("txn without valid until height should never expire")
+	}
+
+	txn.SetValidUntilHeight(10)
+	if txn.Hash() == hash {
+		t.Fatal("valid until height is not part of txn hash")
+	}
+	if IsTxnExpired(txn, 10) {
+		t.Fatal("txn should be valid at its valid until height")
+	}
+	if !IsTxnExpired(txn, 11) {
+		t.Fatal("txn should expire above its valid until height")
+	}
+}
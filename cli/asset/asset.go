@@ -34,29 +34,47 @@ func parseAddress(c *cli.Context) Uint160 {
 	return EmptyUint160
 }
 
+func parseAssetID(c *cli.Context) Uint256 {
+	id := c.String("assetid")
+	if id == "" {
+		return config.NKNAssetID
+	}
+
+	hexAssetID, err := HexStringToBytes(id)
+	if err != nil {
+		fmt.Println("invalid asset id")
+		os.Exit(1)
+	}
+
+	assetID, err := Uint256ParseFromBytes(hexAssetID)
+	if err != nil {
+		fmt.Println("invalid asset id")
+		os.Exit(1)
+	}
+
+	return assetID
+}
+
 func assetAction(c *cli.Context) error {
 	if c.NumFlags() == 0 {
 		cli.ShowSubcommandHelp(c)
 		return nil
 	}
 
+	if file := c.String("transfer-batch"); file != "" {
+		return batchTransferAction(c, file)
+	}
+
 	value := c.String("value")
 	if value == "" {
 		fmt.Println("asset amount is required with [--value]")
 		return nil
 	}
 
-	var txnFee Fixed64
-	fee := c.String("fee")
-	var err error
-	if fee == "" {
-		txnFee = Fixed64(0)
-	} else {
-		txnFee, err = StringToFixed64(fee)
-		if err != nil {
-			fmt.Fprintln(os.Stderr, err)
-			return err
-		}
+	txnFee, err := GetFee(c)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
 	}
 
 	nonce := c.Uint64("nonce")
@@ -101,6 +119,10 @@ func assetAction(c *cli.Context) error {
 			fmt.Fprintln(os.Stderr, err)
 			return err
 		}
+		if err := SetValidUntil(c, myWallet, txn); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return err
+		}
 
 		buff, err := txn.Marshal()
 		if err != nil {
@@ -122,17 +144,22 @@ func assetAction(c *cli.Context) error {
 			os.Exit(1)
 		}
 		receipt := parseAddress(c)
+		assetID := parseAssetID(c)
 		amount, err := StringToFixed64(value)
 		if err != nil {
 			fmt.Fprintln(os.Stderr, err)
 			return err
 		}
 
-		txn, err := MakeTransferTransaction(myWallet, receipt, nonce, amount, txnFee)
+		txn, err := MakeTransferTransaction(myWallet, receipt, assetID, nonce, amount, txnFee)
 		if err != nil {
 			fmt.Fprintln(os.Stderr, err)
 			return err
 		}
+		if err := SetValidUntil(c, myWallet, txn); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return err
+		}
 
 		buff, err := txn.Marshal()
 		if err != nil {
@@ -170,6 +197,10 @@ func NewCommand() *cli.Command {
 				Name:  "transfer, t",
 				Usage: "transfer asset",
 			},
+			cli.StringFlag{
+				Name:  "transfer-batch",
+				Usage: "transfer assets in one transaction to the recipients in a CSV file of address,amount[,assetid] lines",
+			},
 			cli.StringFlag{
 				Name:  "wallet, w",
 				Usage: "wallet name",
@@ -183,6 +214,10 @@ func NewCommand() *cli.Command {
 				Name:  "to",
 				Usage: "asset to whom",
 			},
+			cli.StringFlag{
+				Name:  "assetid",
+				Usage: "ID of the asset to transfer, NKN if omitted",
+			},
 			cli.StringFlag{
 				Name:  "value, v",
 				Usage: "asset amount in transfer asset or totalSupply in inssue assset",
@@ -190,13 +225,14 @@ func NewCommand() *cli.Command {
 			},
 			cli.StringFlag{
 				Name:  "fee, f",
-				Usage: "transaction fee",
+				Usage: "transaction fee, estimated by the node if omitted",
 				Value: "",
 			},
 			cli.Uint64Flag{
 				Name:  "nonce",
 				Usage: "nonce",
 			},
+			NewValidUntilFlag(),
 			cli.StringFlag{
 				Name:  "name",
 				Usage: "asset name",
@@ -0,0 +1,164 @@
+package asset
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	. "github.com/nknorg/nkn/api/common"
+	"github.com/nknorg/nkn/api/httpjson/client"
+	. "github.com/nknorg/nkn/cli/common"
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/vault"
+
+	"github.com/urfave/cli"
+)
+
+// room for the fee field to grow after the size of the txn is measured
+const batchFeeSizeMargin = 16
+
+// readBatchOutputs reads the outputs of a batch transfer from a CSV file with
+// one recipient address, amount and optional asset ID per line. Empty lines
+// and lines starting with # are ignored.
+func readBatchOutputs(file string) ([]*pb.TransferOutput, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comment = '#'
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	outputs := make([]*pb.TransferOutput, 0)
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		entry := len(outputs) + 1
+
+		if len(record) < 2 || len(record) > 3 {
+			return nil, fmt.Errorf("entry %d: expect address,amount[,assetid]", entry)
+		}
+
+		recipient, err := ToScriptHash(strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: invalid address: %v", entry, err)
+		}
+
+		amount, err := StringToFixed64(strings.TrimSpace(record[1]))
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: invalid amount: %v", entry, err)
+		}
+
+		assetID := config.NKNAssetID
+		if len(record) == 3 && strings.TrimSpace(record[2]) != "" {
+			b, err := HexStringToBytes(strings.TrimSpace(record[2]))
+			if err == nil {
+				assetID, err = Uint256ParseFromBytes(b)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("entry %d: invalid asset id: %v", entry, err)
+			}
+		}
+
+		outputs = append(outputs, transaction.NewTransferOutput(recipient, assetID, amount))
+	}
+
+	if len(outputs) == 0 {
+		return nil, fmt.Errorf("no outputs in %s", file)
+	}
+	if len(outputs) > config.MaxBatchTransferOutputs {
+		return nil, fmt.Errorf("%s has %d outputs, a batch transfer can have at most %d", file, len(outputs), config.MaxBatchTransferOutputs)
+	}
+
+	return outputs, nil
+}
+
+// batchTransferFee returns the fee given with --fee, or else the fee estimated
+// by the node for the size of the batch, which is at least the minimum fee of
+// batch transfers.
+func batchTransferFee(c *cli.Context, wallet vault.Wallet, outputs []*pb.TransferOutput, nonce uint64) (Fixed64, error) {
+	if fee := c.String("fee"); fee != "" {
+		return StringToFixed64(fee)
+	}
+
+	txn, err := MakeBatchTransferTransaction(wallet, outputs, nonce, 0)
+	if err != nil {
+		return 0, err
+	}
+	size := txn.GetSize() + batchFeeSizeMargin
+
+	minFee := config.MinBatchTransferFeePerByte * Fixed64(size)
+	fee, err := client.EstimateFeeForSize(Address(), 1, size)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Estimate fee error: %v, using minimum batch transfer fee\n", err)
+		return minFee, nil
+	}
+	if fee < minFee {
+		fee = minFee
+	}
+
+	return fee, nil
+}
+
+func batchTransferAction(c *cli.Context, file string) error {
+	outputs, err := readBatchOutputs(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+
+	myWallet, err := vault.OpenWallet(c.String("wallet"), getPassword(c.String("password")))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	nonce := c.Uint64("nonce")
+	txnFee, err := batchTransferFee(c, myWallet, outputs, nonce)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+
+	txn, err := MakeBatchTransferTransaction(myWallet, outputs, nonce, txnFee)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+	if err := SetValidUntil(c, myWallet, txn); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+
+	buff, err := txn.Marshal()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+
+	fmt.Printf("Sending %d outputs in %d bytes with fee %s\n", len(outputs), txn.GetSize(), txnFee.String())
+
+	resp, err := client.Call(Address(), "sendrawtransaction", 0, map[string]interface{}{"tx": hex.EncodeToString(buff)})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+
+	FormatOutput(resp)
+
+	return nil
+}
@@ -8,8 +8,12 @@ import (
 	"os"
 	"strconv"
 
+	"github.com/nknorg/nkn/api/httpjson/client"
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/transaction"
 	"github.com/nknorg/nkn/util/config"
 	"github.com/nknorg/nkn/util/password"
+	"github.com/nknorg/nkn/vault"
 
 	"github.com/urfave/cli"
 )
@@ -42,6 +46,64 @@ func Address() string {
 	return "http://" + net.JoinHostPort(Ip, Port)
 }
 
+// GetFee returns the txn fee given with --fee. If it is omitted, the fee the
+// node estimates for inclusion in the next block is used, and --fee is
+// required if the node can not estimate it.
+func GetFee(c *cli.Context) (common.Fixed64, error) {
+	if fee := c.String("fee"); fee != "" {
+		return common.StringToFixed64(fee)
+	}
+
+	fee, err := client.EstimateFee(Address(), 1)
+	if err != nil {
+		return 0, fmt.Errorf("estimate fee error: %v, set the fee with --fee", err)
+	}
+
+	return fee, nil
+}
+
+// CallRPC calls method of the node and decodes its result into result.
+func CallRPC(method string, params map[string]interface{}, result interface{}) error {
+	resp, err := client.Call(Address(), method, 0, params)
+	if err != nil {
+		return err
+	}
+
+	var ret struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Message string      `json:"message"`
+			Data    interface{} `json:"data"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(resp, &ret); err != nil {
+		return err
+	}
+	if ret.Error != nil {
+		return fmt.Errorf("%s: %v", ret.Error.Message, ret.Error.Data)
+	}
+
+	return json.Unmarshal(ret.Result, result)
+}
+
+func NewValidUntilFlag() cli.Flag {
+	return cli.UintFlag{
+		Name:  "valid-until",
+		Usage: "height of the last block the transaction can be included in, no limit if omitted",
+	}
+}
+
+// SetValidUntil sets the valid until height given with --valid-until on txn
+// and signs it again, since the height is part of the signed data.
+func SetValidUntil(c *cli.Context, wallet vault.Wallet, txn *transaction.Transaction) error {
+	height := c.Uint("valid-until")
+	if height == 0 {
+		return nil
+	}
+	txn.SetValidUntilHeight(uint32(height))
+	return wallet.Sign(txn)
+}
+
 func PrintError(c *cli.Context, err error, cmd string) {
 	fmt.Println("Incorrect Usage:", err)
 	fmt.Println("")
@@ -29,16 +29,14 @@ func generateIDAction(c *cli.Context) error {
 		os.Exit(1)
 	}
 
-	var txnFee Fixed64
-	fee := c.String("fee")
-	if fee == "" {
-		txnFee = Fixed64(0)
-	} else {
-		txnFee, _ = StringToFixed64(fee)
+	txnFee, err := GetFee(c)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
 	}
 
 	var regFee Fixed64
-	fee = c.String("regfee")
+	fee := c.String("regfee")
 	if fee == "" {
 		regFee = Fixed64(0)
 	} else {
@@ -51,6 +49,10 @@ func generateIDAction(c *cli.Context) error {
 	switch {
 	case c.Bool("genid"):
 		txn, _ := MakeGenerateIDTransaction(myWallet, regFee, nonce, txnFee)
+		if err := SetValidUntil(c, myWallet, txn); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return err
+		}
 		buff, _ := txn.Marshal()
 		resp, err = client.Call(Address(), "sendrawtransaction", 0, map[string]interface{}{"tx": hex.EncodeToString(buff)})
 	default:
@@ -93,13 +95,14 @@ func NewCommand() *cli.Command {
 			},
 			cli.StringFlag{
 				Name:  "fee, f",
-				Usage: "transaction fee",
+				Usage: "transaction fee, estimated by the node if omitted",
 				Value: "",
 			},
 			cli.Uint64Flag{
 				Name:  "nonce",
 				Usage: "nonce",
 			},
+			NewValidUntilFlag(),
 		},
 		Action: generateIDAction,
 		OnUsageError: func(c *cli.Context, err error, isSubcommand bool) error {
@@ -0,0 +1,115 @@
+package info
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	. "github.com/nknorg/nkn/cli/common"
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/consensus/trace"
+)
+
+var emptyVote = common.EmptyUint256.ToHexString()
+
+// voteString labels the empty vote, which rejects all proposals.
+func voteString(vote string) string {
+	if vote == emptyVote {
+		return "reject"
+	}
+	return vote
+}
+
+// sinceStart formats a unix nano time relative to the start of the height.
+func sinceStart(h *trace.Height, t int64) string {
+	return fmt.Sprintf("+%dms", (t-h.StartTime)/int64(time.Millisecond))
+}
+
+// showConsensusList prints the summaries of all heights recorded by the node.
+func showConsensusList() error {
+	var summaries []*trace.Summary
+	if err := CallRPC("getconsensustrace", map[string]interface{}{}, &summaries); err != nil {
+		return err
+	}
+
+	for _, s := range summaries {
+		result := "no result"
+		if s.Result != "" {
+			result = voteString(s.Result)
+		}
+		fmt.Printf("%d\t%d proposals\t%d votes\t%dms\t%s\n", s.Height, s.Proposals, s.Votes, s.LatencyMs, result)
+	}
+
+	return nil
+}
+
+// showConsensus prints how the election at height was decided.
+func showConsensus(height int) error {
+	h := &trace.Height{}
+	if err := CallRPC("getconsensustrace", map[string]interface{}{"height": height}, h); err != nil {
+		return err
+	}
+
+	fmt.Printf("Height %d, first seen at %s\n", h.Height, time.Unix(0, h.StartTime).Format(time.RFC3339Nano))
+
+	switch {
+	case h.Result == nil:
+		fmt.Println("Result: election has not finished")
+	case h.Result.Error != "":
+		fmt.Printf("Result: no consensus after %dms: %s\n", h.Result.LatencyMs, h.Result.Error)
+	case !h.Result.Accepted:
+		fmt.Printf("Result: rejected after %dms with %d/%d neighbor votes\n", h.Result.LatencyMs, h.Result.Votes, h.Result.NeighborVotes)
+	default:
+		fmt.Printf("Result: accepted %s after %dms with %d/%d neighbor votes\n", h.Result.BlockHash, h.Result.LatencyMs, h.Result.Votes, h.Result.NeighborVotes)
+	}
+
+	fmt.Printf("\nProposals (%d):\n", len(h.Proposals))
+	for _, p := range h.Proposals {
+		status := "not verified"
+		if p.Verified && p.Accepted {
+			status = "accepted"
+		} else if p.Verified {
+			status = "rejected: " + p.Reason
+		}
+		fmt.Printf("  %s %s by %s, %d txns, %s\n", sinceStart(h, p.Time), p.BlockHash, p.Proposer, p.TxnCount, status)
+	}
+
+	if h.SelfVote != "" {
+		fmt.Printf("\nSelf vote: %s\n", voteString(h.SelfVote))
+	}
+
+	votes := make(map[string]int)
+	for _, v := range h.Votes {
+		votes[v.Vote]++
+	}
+	hashes := make([]string, 0, len(votes))
+	for hash := range votes {
+		hashes = append(hashes, hash)
+	}
+	sort.Slice(hashes, func(i, j int) bool { return votes[hashes[i]] > votes[hashes[j]] })
+
+	fmt.Printf("\nNeighbor votes (%d neighbors):\n", len(h.Votes))
+	for _, hash := range hashes {
+		fmt.Printf("  %s: %d\n", voteString(hash), votes[hash])
+	}
+
+	fmt.Printf("\nVote changes (%d):\n", len(h.VoteChanges))
+	for _, c := range h.VoteChanges {
+		from := "none"
+		if c.From != "" {
+			from = voteString(c.From)
+		}
+		fmt.Printf("  %s %s: %s -> %s\n", sinceStart(h, c.Time), c.Voter, from, voteString(c.To))
+	}
+
+	fmt.Printf("\nLeading vote (%d samples):\n", len(h.LeadingVotes))
+	for _, l := range h.LeadingVotes {
+		fmt.Printf("  %s %s weight %d (%.1f%%)\n", sinceStart(h, l.Time), voteString(l.Vote), l.Weight, l.RelativeWeight*100)
+	}
+
+	if h.DroppedEvents > 0 {
+		fmt.Printf("\n%d events dropped after reaching the limit of events per height\n", h.DroppedEvents)
+	}
+
+	return nil
+}
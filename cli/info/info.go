@@ -28,6 +28,8 @@ func infoAction(c *cli.Context) (err error) {
 	balance := c.String("balance")
 	nonce := c.String("nonce")
 	id := c.String("id")
+	consensusHeight := c.Int("consensus")
+	evidence := c.Bool("evidence")
 
 	var resp []byte
 	var output [][]byte
@@ -149,10 +151,29 @@ func infoAction(c *cli.Context) (err error) {
 		output = append(output, resp)
 	}
 
+	if evidence {
+		resp, err := client.Call(Address(), "getequivocationevidence", 0, map[string]interface{}{})
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return err
+		}
+		output = append(output, resp)
+	}
+
 	for _, v := range output {
 		FormatOutput(v)
 	}
 
+	if consensusHeight > 0 {
+		err = showConsensus(consensusHeight)
+	} else if consensusHeight == 0 {
+		err = showConsensusList()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+
 	return nil
 }
 
@@ -216,6 +237,15 @@ func NewCommand() *cli.Command {
 				Name:  "id",
 				Usage: "id from publickey",
 			},
+			cli.IntFlag{
+				Name:  "consensus",
+				Usage: "how consensus was reached at a recent height, 0 to list recorded heights",
+				Value: -1,
+			},
+			cli.BoolFlag{
+				Name:  "evidence",
+				Usage: "conflicting votes and proposals signed by the same node",
+			},
 		},
 		Action: infoAction,
 		OnUsageError: func(c *cli.Context, err error, isSubcommand bool) error {
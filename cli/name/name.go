@@ -9,6 +9,7 @@ import (
 	"github.com/nknorg/nkn/api/httpjson/client"
 	. "github.com/nknorg/nkn/cli/common"
 	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/transaction"
 	"github.com/nknorg/nkn/util/config"
 	"github.com/nknorg/nkn/vault"
 
@@ -29,12 +30,10 @@ func nameAction(c *cli.Context) error {
 		os.Exit(1)
 	}
 
-	var txnFee Fixed64
-	fee := c.String("fee")
-	if fee == "" {
-		txnFee = Fixed64(0)
-	} else {
-		txnFee, _ = StringToFixed64(fee)
+	txnFee, err := GetFee(c)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
 	}
 
 	nonce := c.Uint64("nonce")
@@ -48,6 +47,41 @@ func nameAction(c *cli.Context) error {
 			return nil
 		}
 		txn, _ := MakeRegisterNameTransaction(myWallet, name, nonce, txnFee)
+		if err := SetValidUntil(c, myWallet, txn); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return err
+		}
+		buff, _ := txn.Marshal()
+		resp, err = client.Call(Address(), "sendrawtransaction", 0, map[string]interface{}{"tx": hex.EncodeToString(buff)})
+	case c.Bool("transfer"):
+		name := c.String("name")
+		if name == "" {
+			fmt.Println("name is required with [--name]")
+			return nil
+		}
+
+		to := c.String("to")
+		if to == "" {
+			fmt.Println("recipient public key is required with [--to]")
+			return nil
+		}
+		var recipient []byte
+		recipient, err = HexStringToBytes(to)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return err
+		}
+
+		var txn *transaction.Transaction
+		txn, err = MakeTransferNameTransaction(myWallet, name, recipient, nonce, txnFee)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return err
+		}
+		if err := SetValidUntil(c, myWallet, txn); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return err
+		}
 		buff, _ := txn.Marshal()
 		resp, err = client.Call(Address(), "sendrawtransaction", 0, map[string]interface{}{"tx": hex.EncodeToString(buff)})
 	case c.Bool("del"):
@@ -58,6 +92,10 @@ func nameAction(c *cli.Context) error {
 		}
 
 		txn, _ := MakeDeleteNameTransaction(myWallet, name, nonce, txnFee)
+		if err := SetValidUntil(c, myWallet, txn); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return err
+		}
 		buff, _ := txn.Marshal()
 		resp, err = client.Call(Address(), "sendrawtransaction", 0, map[string]interface{}{"tx": hex.EncodeToString(buff)})
 	default:
@@ -82,7 +120,11 @@ func NewCommand() *cli.Command {
 		Flags: []cli.Flag{
 			cli.BoolFlag{
 				Name:  "reg, r",
-				Usage: "register name for your address",
+				Usage: "register name for your address, or renew the name already registered",
+			},
+			cli.BoolFlag{
+				Name:  "transfer, t",
+				Usage: "transfer name of your address to another public key",
 			},
 			cli.BoolFlag{
 				Name:  "del, d",
@@ -92,6 +134,10 @@ func NewCommand() *cli.Command {
 				Name:  "name",
 				Usage: "name",
 			},
+			cli.StringFlag{
+				Name:  "to",
+				Usage: "public key of the new name owner",
+			},
 			cli.StringFlag{
 				Name:  "wallet, w",
 				Usage: "wallet name",
@@ -103,13 +149,14 @@ func NewCommand() *cli.Command {
 			},
 			cli.StringFlag{
 				Name:  "fee, f",
-				Usage: "transaction fee",
+				Usage: "transaction fee, estimated by the node if omitted",
 				Value: "",
 			},
 			cli.Uint64Flag{
 				Name:  "nonce",
 				Usage: "nonce",
 			},
+			NewValidUntilFlag(),
 		},
 		Action: nameAction,
 		OnUsageError: func(c *cli.Context, err error, isSubcommand bool) error {
@@ -0,0 +1,178 @@
+package snapshot
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/chain/db"
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/log"
+
+	"github.com/urfave/cli"
+)
+
+func openLedgerStore(readOnly bool) (*db.ChainStore, error) {
+	if err := config.Init(); err != nil {
+		return nil, err
+	}
+
+	if err := log.Init(); err != nil {
+		return nil, err
+	}
+
+	var store *db.ChainStore
+	var err error
+	if readOnly {
+		store, err = db.NewReadOnlyLedgerStore()
+	} else {
+		store, err = db.NewLedgerStore()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := chain.NewBlockchainWithGenesisBlock(store); err != nil {
+		store.Close()
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func exportAction(c *cli.Context) error {
+	file := c.String("file")
+	if file == "" {
+		fmt.Fprintln(os.Stderr, "snapshot file is required")
+		return nil
+	}
+
+	store, err := openLedgerStore(true)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+	defer store.Close()
+
+	height := store.GetHeight()
+	if c.IsSet("height") {
+		height = uint32(c.Uint("height"))
+	}
+
+	f, err := os.Create(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	if err := store.ExportSnapshot(w, height); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+
+	hash, err := store.GetBlockHash(height)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+
+	fmt.Printf("Exported snapshot at height %d, block hash %s to %s\n", height, hash.ToHexString(), file)
+
+	return nil
+}
+
+func importAction(c *cli.Context) error {
+	file := c.String("file")
+	if file == "" {
+		fmt.Fprintln(os.Stderr, "snapshot file is required")
+		return nil
+	}
+
+	hashStr := c.String("hash")
+	if hashStr == "" {
+		fmt.Fprintln(os.Stderr, "trusted block hash of the snapshot height is required")
+		return nil
+	}
+	hashBytes, err := common.HexStringToBytes(hashStr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+	trustedHash, err := common.Uint256ParseFromBytes(hashBytes)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+	defer f.Close()
+
+	store, err := openLedgerStore(false)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+	defer store.Close()
+
+	height, err := store.ImportSnapshot(bufio.NewReader(f), trustedHash)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+
+	fmt.Printf("Imported snapshot at height %d, block hash %s\n", height, trustedHash.ToHexString())
+
+	return nil
+}
+
+func NewCommand() *cli.Command {
+	fileFlag := cli.StringFlag{
+		Name:  "file, f",
+		Usage: "snapshot file",
+	}
+
+	return &cli.Command{
+		Name:        "snapshot",
+		Usage:       "export or import chain state snapshot",
+		Description: "With nknd snapshot, you could export chain state at a height to a file, or bootstrap a new chain db from it. Export opens the chain db read-only and may run while the node is running, import requires the node to be stopped.",
+		ArgsUsage:   "[args]",
+		Subcommands: []cli.Command{
+			{
+				Name:   "export",
+				Usage:  "export chain state at a height to a snapshot file",
+				Action: exportAction,
+				Flags: []cli.Flag{
+					fileFlag,
+					cli.UintFlag{
+						Name:  "height",
+						Usage: "block height of the snapshot, current height if omitted",
+					},
+				},
+			},
+			{
+				Name:   "import",
+				Usage:  "seed a new chain db from a snapshot file",
+				Action: importAction,
+				Flags: []cli.Flag{
+					fileFlag,
+					cli.StringFlag{
+						Name:  "hash",
+						Usage: "block hash at the snapshot height from a trusted source, such as a block explorer or a node you run",
+					},
+				},
+			},
+		},
+	}
+}
@@ -8,7 +8,6 @@ import (
 	. "github.com/nknorg/nkn/api/common"
 	"github.com/nknorg/nkn/api/httpjson/client"
 	. "github.com/nknorg/nkn/cli/common"
-	. "github.com/nknorg/nkn/common"
 	"github.com/nknorg/nkn/util/config"
 	"github.com/nknorg/nkn/vault"
 
@@ -29,12 +28,10 @@ func subscribeAction(c *cli.Context) error {
 		os.Exit(1)
 	}
 
-	var txnFee Fixed64
-	fee := c.String("fee")
-	if fee == "" {
-		txnFee = Fixed64(0)
-	} else {
-		txnFee, _ = StringToFixed64(fee)
+	txnFee, err := GetFee(c)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
 	}
 
 	nonce := c.Uint64("nonce")
@@ -64,6 +61,10 @@ func subscribeAction(c *cli.Context) error {
 		}
 
 		txn, _ := MakeSubscribeTransaction(myWallet, id, topic, uint32(bucket), uint32(duration), meta, nonce, txnFee)
+		if err := SetValidUntil(c, myWallet, txn); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return err
+		}
 		buff, _ := txn.Marshal()
 		resp, err = client.Call(Address(), "sendrawtransaction", 0, map[string]interface{}{"tx": hex.EncodeToString(buff)})
 	default:
@@ -121,13 +122,14 @@ func NewCommand() *cli.Command {
 			},
 			cli.StringFlag{
 				Name:  "fee, f",
-				Usage: "transaction fee",
+				Usage: "transaction fee, estimated by the node if omitted",
 				Value: "",
 			},
 			cli.Uint64Flag{
 				Name:  "nonce",
 				Usage: "nonce",
 			},
+			NewValidUntilFlag(),
 		},
 		Action: subscribeAction,
 		OnUsageError: func(c *cli.Context, err error, isSubcommand bool) error {
@@ -0,0 +1,116 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nknorg/nkn/api/httpjson/client"
+	"github.com/nknorg/nkn/chain/pool"
+	. "github.com/nknorg/nkn/cli/common"
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/vault"
+)
+
+type pendingTxnInfo struct {
+	TxType      string `json:"txType"`
+	PayloadData string `json:"payloadData"`
+	Nonce       uint64 `json:"nonce"`
+	Fee         int64  `json:"fee"`
+	Attributes  string `json:"attributes"`
+	ValidUntil  uint32 `json:"validUntilHeight"`
+	Hash        string `json:"hash"`
+}
+
+// bumpFee re-signs the pending txn txHash of the wallet account with the same
+// nonce and a higher fee, and sends it to replace the original one. If fee is
+// empty, the minimum fee accepted by the node for replacement is used.
+func bumpFee(wallet vault.Wallet, txHash string, fee string) ([]byte, error) {
+	account, err := wallet.GetDefaultAccount()
+	if err != nil {
+		return nil, err
+	}
+	address, err := account.ProgramHash.ToAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []pendingTxnInfo
+	err = CallRPC("getrawmempool", map[string]interface{}{"action": "txnlist", "address": address}, &pending)
+	if err != nil {
+		return nil, err
+	}
+
+	var old *pendingTxnInfo
+	for i := range pending {
+		if strings.EqualFold(pending[i].Hash, txHash) {
+			old = &pending[i]
+			break
+		}
+	}
+	if old == nil {
+		return nil, fmt.Errorf("txn %s of %s is not in txpool, it may have been included in a block already", txHash, address)
+	}
+
+	payloadType, ok := pb.PayloadType_value[old.TxType]
+	if !ok {
+		return nil, fmt.Errorf("unknown txn type %s", old.TxType)
+	}
+	if pb.PayloadType(payloadType) == pb.NANO_PAY_TYPE {
+		return nil, errors.New("nano pay txn can not be replaced by fee")
+	}
+
+	var info struct {
+		MinFeeBump uint32 `json:"minfeebump"`
+	}
+	if err := CallRPC("getrawmempool", map[string]interface{}{"action": "info"}, &info); err != nil {
+		// node does not report its replacement rule, assume the default one
+		info.MinFeeBump = config.DefaultTxPoolMinFeeBump
+	}
+	minFee := Fixed64(pool.MinReplacementFee(old.Fee, info.MinFeeBump))
+
+	newFee := minFee
+	if fee != "" {
+		newFee, err = StringToFixed64(fee)
+		if err != nil {
+			return nil, err
+		}
+		if newFee < minFee {
+			return nil, fmt.Errorf("fee %s is too low, replacing txn with fee %s needs fee at least %s", newFee.String(), Fixed64(old.Fee).String(), minFee.String())
+		}
+	}
+
+	data, err := hex.DecodeString(old.PayloadData)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := hex.DecodeString(old.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &pb.Payload{
+		Type: pb.PayloadType(payloadType),
+		Data: data,
+	}
+	txn := &transaction.Transaction{
+		Transaction: transaction.NewMsgTx(payload, old.Nonce, newFee, attrs),
+	}
+	txn.SetValidUntilHeight(old.ValidUntil)
+	if err := wallet.Sign(txn); err != nil {
+		return nil, err
+	}
+
+	buff, err := txn.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("Replacing txn %s (nonce %d, fee %s) with fee %s\n", old.Hash, old.Nonce, Fixed64(old.Fee).String(), newFee.String())
+
+	return client.Call(Address(), "sendrawtransaction", 0, map[string]interface{}{"tx": hex.EncodeToString(buff)})
+}
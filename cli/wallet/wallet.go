@@ -132,6 +132,22 @@ func walletAction(c *cli.Context) error {
 		return nil
 	}
 
+	// replace a pending txn with a higher fee
+	if txHash := c.String("bump-fee"); txHash != "" {
+		wallet, err := vault.OpenWallet(name, getPassword(passwd))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		resp, err := bumpFee(wallet, txHash, c.String("fee"))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return err
+		}
+		FormatOutput(resp)
+		return nil
+	}
+
 	// change password
 	if c.Bool("changepassword") {
 		fmt.Printf("Wallet File: '%s'\n", name)
@@ -169,6 +185,14 @@ func NewCommand() *cli.Command {
 				Name:  "list, l",
 				Usage: "list wallet information [account, balance, verbose, nonce]",
 			},
+			cli.StringFlag{
+				Name:  "bump-fee",
+				Usage: "replace a pending transaction by hash with the same nonce and a higher fee",
+			},
+			cli.StringFlag{
+				Name:  "fee, f",
+				Usage: "new transaction fee for --bump-fee, the minimum accepted replacement fee if omitted",
+			},
 			cli.BoolFlag{
 				Name:  "changepassword",
 				Usage: "change wallet password",
@@ -28,4 +28,11 @@ const (
 	consensusMinRelativeWeight  = 2.0 / 3.0
 	syncMinRelativeWeight       = 1.0 / 2.0
 	requestTransactionType      = pb.REQUEST_TRANSACTION_SHORT_HASH
+	traceHeights                = 256
+	traceFutureHeights          = 2
+	traceMaxEvents              = 1024
+	evidenceKeepHeights         = 8
+	evidenceFutureHeights       = 2
+	maxEvidence                 = 1024
+	signedVoteProtocolVersion   = 2
 )
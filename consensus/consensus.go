@@ -1,6 +1,7 @@
 package moca
 
 import (
+	"encoding/binary"
 	"fmt"
 	"sync"
 	"time"
@@ -9,24 +10,32 @@ import (
 	"github.com/nknorg/nkn/chain"
 	"github.com/nknorg/nkn/common"
 	"github.com/nknorg/nkn/consensus/election"
+	"github.com/nknorg/nkn/consensus/evidence"
+	"github.com/nknorg/nkn/consensus/trace"
+	"github.com/nknorg/nkn/crypto/util"
 	"github.com/nknorg/nkn/event"
 	"github.com/nknorg/nkn/node"
 	"github.com/nknorg/nkn/pb"
 	"github.com/nknorg/nkn/por"
 	"github.com/nknorg/nkn/util/config"
 	"github.com/nknorg/nkn/util/log"
+	"github.com/nknorg/nkn/util/timer"
 	"github.com/nknorg/nkn/vault"
 )
 
 // Consensus is the Majority vOte Cellular Automata (MOCA) consensus layer
 type Consensus struct {
 	account             *vault.Account
-	localNode           *node.LocalNode
+	localNode           Transport
+	ledger              Ledger
+	clock               timer.Clock
 	startOnce           sync.Once
 	proposals           common.Cache
 	requestProposalChan chan *requestProposalInfo
 	mining              chain.Mining
-	txnCollector        *chain.TxnCollector
+	trace               *trace.Trace
+	evidencePool        *evidence.Pool
+	voteScoredHeight    uint32 // height up to which votes are scored, only used by startConsensus
 
 	electionsLock sync.RWMutex
 	elections     common.Cache
@@ -40,22 +49,55 @@ type Consensus struct {
 
 	acceptedHeightLock sync.RWMutex
 	acceptedHeight     uint32
+
+	voteSeqLock sync.Mutex
+	voteSeq     map[uint32]uint32
+	voteNonce   uint32 // random for each run, as vote seq is not persisted
+}
+
+// Config is what consensus runs on. NewConsensus runs consensus on the local
+// node, the ledger and the system clock, other configs are used to run it in
+// a simulator.
+type Config struct {
+	Transport Transport
+	Ledger    Ledger
+	Clock     timer.Clock
+	Mining    chain.Mining
 }
 
 // NewConsensus creates a MOCA consensus
 func NewConsensus(account *vault.Account, localNode *node.LocalNode) (*Consensus, error) {
 	txnCollector := chain.NewTxnCollector(localNode.GetTxnPool(), int(config.Parameters.NumTxnPerBlock))
+	return NewConsensusWithConfig(account, &Config{
+		Transport: &localNodeTransport{localNode},
+		Ledger:    chainLedger{},
+		Clock:     timer.SystemClock,
+		Mining:    chain.NewBuiltinMining(account, txnCollector),
+	})
+}
+
+// NewConsensusWithConfig creates a MOCA consensus that runs on cfg
+func NewConsensusWithConfig(account *vault.Account, cfg *Config) (*Consensus, error) {
 	consensus := &Consensus{
 		account:             account,
-		localNode:           localNode,
+		localNode:           cfg.Transport,
+		ledger:              cfg.Ledger,
+		clock:               cfg.Clock,
 		elections:           common.NewGoCache(cacheExpiration, cacheCleanupInterval),
 		proposals:           common.NewGoCache(cacheExpiration, cacheCleanupInterval),
 		proposalChan:        make(chan *block.Block, proposalChanLen),
 		requestProposalChan: make(chan *requestProposalInfo, requestProposalChanLen),
-		mining:              chain.NewBuiltinMining(account, txnCollector),
-		txnCollector:        txnCollector,
-		expectedHeight:      chain.DefaultLedger.Store.GetHeight() + 1,
+		mining:              cfg.Mining,
+		trace:               trace.NewTrace(traceHeights, traceFutureHeights, traceMaxEvents),
+		evidencePool:        evidence.NewPool(evidenceKeepHeights, evidenceFutureHeights, maxEvidence),
+		expectedHeight:      cfg.Ledger.GetHeight() + 1,
+		voteSeq:             make(map[uint32]uint32),
+		voteNonce:           binary.LittleEndian.Uint32(util.RandomBytes(4)),
 	}
+	consensus.trace.SetExpectedHeight(consensus.expectedHeight)
+	consensus.evidencePool.SetExpectedHeight(consensus.expectedHeight)
+	consensus.localNode.SetConsensusTrace(consensus.trace)
+	consensus.localNode.SetEvidencePool(consensus.evidencePool)
 	return consensus, nil
 }
 
@@ -72,33 +114,36 @@ func (consensus *Consensus) Start() {
 
 // startConsensus starts the voting routine
 func (consensus *Consensus) startConsensus() {
+	consensus.voteScoredHeight = consensus.ledger.GetHeight()
 	for {
 		consensus.maybeUpdateConsensusHeight()
+		consensus.updateVoteReputation()
 
 		consensusHeight := consensus.GetExpectedHeight()
 
 		if consensusHeight == 0 {
-			time.Sleep(50 * time.Millisecond)
+			consensus.clock.Sleep(50 * time.Millisecond)
 			continue
 		}
 
 		elc, err := consensus.waitAndHandleProposal()
 		if err != nil {
 			log.Warningf("Handle proposal error: %v", err)
-			time.Sleep(50 * time.Millisecond)
+			consensus.clock.Sleep(50 * time.Millisecond)
 			continue
 		}
 
 		err = consensus.prefillNeighborVotes(elc, consensusHeight)
 		if err != nil {
 			log.Warningf("Prefill neighbor votes error: %v", err)
-			time.Sleep(50 * time.Millisecond)
+			consensus.clock.Sleep(50 * time.Millisecond)
 			continue
 		}
 
 		consensus.setExpectedHeight(consensusHeight + 1)
 
 		electedBlockHash, err := consensus.startElection(consensusHeight, elc)
+		consensus.trace.SetResult(consensusHeight, electedBlockHash, len(elc.GetNeighborIDsByVote(electedBlockHash)), int(elc.NeighborVoteCount()), err)
 		if err != nil {
 			log.Errorf("Election error: %v", err)
 			consensus.setExpectedHeight(consensusHeight)
@@ -154,6 +199,8 @@ func (consensus *Consensus) startElection(height uint32, elc *election.Election)
 			log.Errorf("Convert vote %v to block hash error", vote)
 		}
 
+		consensus.trace.SelfVote(height, votedBlockHash)
+
 		err := consensus.vote(height, votedBlockHash)
 		if err != nil {
 			log.Errorf("Send vote error: %v", err)
@@ -194,6 +241,13 @@ func (consensus *Consensus) loadOrCreateElection(height uint32) (*election.Elect
 		MaxVotingInterval:           maxVotingInterval,
 		ChangeVoteMinRelativeWeight: changeVoteMinRelativeWeight,
 		ConsensusMinRelativeWeight:  consensusMinRelativeWeight,
+		GetWeight:                   consensus.getNeighborWeight,
+		Clock:                       consensus.clock,
+		OnLeadingVote: func(vote interface{}, absWeight uint32, relWeight float32) {
+			if blockHash, ok := vote.(common.Uint256); ok {
+				consensus.trace.LeadingVote(height, blockHash, absWeight, relWeight)
+			}
+		},
 	}
 
 	elc, err := election.NewElection(config)
@@ -232,6 +286,8 @@ func (consensus *Consensus) setExpectedHeight(expectedHeight uint32) {
 
 		consensus.expectedHeight = expectedHeight
 		consensus.proposalChan = make(chan *block.Block, proposalChanLen)
+		consensus.trace.SetExpectedHeight(expectedHeight)
+		consensus.evidencePool.SetExpectedHeight(expectedHeight)
 	}
 	consensus.proposalLock.Unlock()
 }
@@ -278,11 +334,11 @@ func (consensus *Consensus) saveAcceptedBlock(electedBlockHash common.Uint256) e
 	}
 
 	syncState := consensus.localNode.GetSyncState()
-	if block.Header.UnsignedHeader.Height == chain.DefaultLedger.Store.GetHeight()+1 {
+	if block.Header.UnsignedHeader.Height == consensus.ledger.GetHeight()+1 {
 		if syncState == pb.WAIT_FOR_SYNCING {
 			consensus.localNode.SetSyncState(pb.PERSIST_FINISHED)
 		}
-		err = chain.DefaultLedger.Blockchain.AddBlock(block, false)
+		err = consensus.ledger.AddBlock(block)
 		if err != nil {
 			return err
 		}
@@ -294,7 +350,7 @@ func (consensus *Consensus) saveAcceptedBlock(electedBlockHash common.Uint256) e
 		return nil
 	}
 
-	log.Infof("Accepted block height: %d, local ledger block height: %d, sync needed.", block.Header.UnsignedHeader.Height, chain.DefaultLedger.Store.GetHeight())
+	log.Infof("Accepted block height: %d, local ledger block height: %d, sync needed.", block.Header.UnsignedHeader.Height, consensus.ledger.GetHeight())
 
 	elc, loaded, err := consensus.loadOrCreateElection(block.Header.UnsignedHeader.Height)
 	if err != nil {
@@ -305,10 +361,10 @@ func (consensus *Consensus) saveAcceptedBlock(electedBlockHash common.Uint256) e
 	}
 
 	neighborIDs := elc.GetNeighborIDsByVote(electedBlockHash)
-	neighbors := consensus.localNode.GetNeighbors(func(neighbor *node.RemoteNode) bool {
+	neighbors := consensus.localNode.GetNeighbors(func(neighbor Neighbor) bool {
 		for _, neighborID := range neighborIDs {
 			if neighbor.GetID() == neighborID {
-				return neighbor.GetHeight() > chain.DefaultLedger.Store.GetHeight()
+				return neighbor.GetHeight() > consensus.ledger.GetHeight()
 			}
 		}
 		return false
@@ -339,7 +395,7 @@ func (consensus *Consensus) saveAcceptedBlock(electedBlockHash common.Uint256) e
 			return
 		}
 
-		consensus.localNode.SetMinVerifiableHeight(chain.DefaultLedger.Store.GetHeight() + por.SigChainMiningHeightOffset)
+		consensus.localNode.SetMinVerifiableHeight(consensus.ledger.GetHeight() + por.SigChainMiningHeightOffset)
 
 		consensus.localNode.SetSyncState(pb.PERSIST_FINISHED)
 	}()
@@ -379,7 +435,7 @@ func (consensus *Consensus) saveBlocksAcceptedDuringSync(startHeight uint32) err
 			return err
 		}
 
-		err = chain.DefaultLedger.Blockchain.AddBlock(block, false)
+		err = consensus.ledger.AddBlock(block)
 		if err != nil {
 			return err
 		}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"sync"
 	"time"
+
+	"github.com/nknorg/nkn/util/timer"
 )
 
 type electionState uint8
@@ -25,6 +27,8 @@ type Config struct {
 	ConsensusMinRelativeWeight  float32
 	ConsensusMinAbsoluteWeight  uint32
 	GetWeight                   func(interface{}) uint32
+	OnLeadingVote               func(vote interface{}, absWeight uint32, relWeight float32) // optional, called whenever votes are counted
+	Clock                       timer.Clock                                                 // optional, defaults to the system clock
 }
 
 // Election is the structure of an election.
@@ -54,6 +58,10 @@ func NewElection(config *Config) (*Election, error) {
 		config.GetWeight = func(interface{}) uint32 { return 1 }
 	}
 
+	if config.Clock == nil {
+		config.Clock = timer.SystemClock
+	}
+
 	election := &Election{
 		Config:       config,
 		state:        initialized,
@@ -90,7 +98,7 @@ func (election *Election) Start() bool {
 
 		go election.updateVote()
 
-		time.AfterFunc(election.Duration, func() {
+		election.Clock.AfterFunc(election.Duration, func() {
 			election.Stop()
 		})
 
@@ -181,6 +189,16 @@ func (election *Election) GetNeighborIDsByVote(vote interface{}) []interface{} {
 	return neighborIDs
 }
 
+// GetNeighborVotes returns the latest vote of each neighbor.
+func (election *Election) GetNeighborVotes() map[interface{}]interface{} {
+	votes := make(map[interface{}]interface{})
+	election.neighborVotes.Range(func(key, value interface{}) bool {
+		votes[key] = value
+		return true
+	})
+	return votes
+}
+
 // NeighborVoteCount counts the number of neighbor votes received.
 func (election *Election) NeighborVoteCount() uint32 {
 	count := uint32(0)
@@ -212,14 +230,14 @@ func (election *Election) PrefillNeighborVotes(neighborIDs []interface{}, vote i
 // updateVote updates self vote and write vote into txVoteChan if self vote
 // changes with throttle.
 func (election *Election) updateVote() {
-	votingTimer := time.NewTimer(election.MaxVotingInterval)
+	votingTimer := election.Clock.NewTimer(election.MaxVotingInterval)
 
-	time.Sleep(election.MinVotingInterval)
+	election.Clock.Sleep(election.MinVotingInterval)
 
 	for {
 		select {
 		case <-election.voteReceived:
-		case <-votingTimer.C:
+		case <-votingTimer.C():
 		}
 
 		if election.IsStopped() {
@@ -232,6 +250,10 @@ func (election *Election) updateVote() {
 		selfVote := election.selfVote
 		election.RUnlock()
 
+		if election.OnLeadingVote != nil {
+			election.OnLeadingVote(leadingVote, absWeight, relWeight)
+		}
+
 		if absWeight >= election.ChangeVoteMinAbsoluteWeight && relWeight >= election.ChangeVoteMinRelativeWeight {
 			if selfVote != leadingVote {
 				election.Lock()
@@ -242,12 +264,12 @@ func (election *Election) updateVote() {
 
 				if !votingTimer.Stop() {
 					select {
-					case <-votingTimer.C:
+					case <-votingTimer.C():
 					default:
 					}
 				}
-				votingTimer = time.NewTimer(election.MaxVotingInterval)
-				time.Sleep(election.MinVotingInterval)
+				votingTimer = election.Clock.NewTimer(election.MaxVotingInterval)
+				election.Clock.Sleep(election.MinVotingInterval)
 			}
 		}
 	}
@@ -278,5 +300,9 @@ func (election *Election) getLeadingVote() (interface{}, uint32, float32) {
 		}
 	}
 
+	if totalWeight == 0 {
+		return majorityVote, 0, 0
+	}
+
 	return majorityVote, maxWeight, float32(maxWeight) / float32(totalWeight)
 }
@@ -0,0 +1,84 @@
+package moca
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/crypto"
+	"github.com/nknorg/nkn/node"
+	"github.com/nknorg/nkn/signature"
+	"github.com/nknorg/nkn/util/log"
+)
+
+// nextVoteSeq returns the sequence number of the next vote sent at height.
+func (consensus *Consensus) nextVoteSeq(height uint32) uint32 {
+	consensus.voteSeqLock.Lock()
+	defer consensus.voteSeqLock.Unlock()
+
+	for h := range consensus.voteSeq {
+		if h < height && height-h >= evidenceKeepHeights {
+			delete(consensus.voteSeq, h)
+		}
+	}
+
+	consensus.voteSeq[height]++
+
+	return consensus.voteSeq[height]
+}
+
+// checkVoteEquivocation keeps a signed vote as evidence candidate and reports
+// if the sender has signed a different vote with the same sequence number and
+// nonce. Unsigned votes from nodes that do not sign votes yet are skipped.
+// Nodes that sign votes can not avoid detection by leaving votes unsigned or
+// by changing nonce, since voteMessageHandler rejects unsigned votes from them
+// and a nonce other than the first one of the connected session.
+//
+// Votes are only sent to neighbors and are not relayed, so conflicting votes
+// are only detected when the same neighbor receives both of them. A node that
+// sends different votes to different neighbors, or reconnects to get a new
+// session, is not detected.
+func (consensus *Consensus) checkVoteEquivocation(remoteMessage *node.RemoteMessage, height, seq, nonce uint32, blockHash common.Uint256) {
+	if len(remoteMessage.Signature) == 0 || seq == 0 {
+		return
+	}
+
+	pubKey := remoteMessage.Sender.GetPubKey()
+	if pubKey == nil {
+		return
+	}
+
+	e := consensus.evidencePool.AddVote(height, seq, nonce, remoteMessage.Sender.GetID(), pubKey.EncodePoint(), blockHash, remoteMessage.Signed, remoteMessage.Signature)
+	if e != nil {
+		log.Warningf("Neighbor %s sent conflicting votes %s and %s at height %d seq %d nonce %d", e.NodeID, e.First.BlockHash, e.Second.BlockHash, height, seq, nonce)
+	}
+}
+
+// checkProposalEquivocation keeps the signed header of a proposal as evidence
+// candidate and reports if its signer has signed a different header at the
+// same height. Headers with invalid signature, or whose signer ID is not the
+// one registered for the signer public key, are skipped so they cannot be used
+// to frame a node.
+func (consensus *Consensus) checkProposalEquivocation(b *block.Block) {
+	header := b.Header.UnsignedHeader
+	id, err := consensus.ledger.GetID(header.SignerPk)
+	if err != nil || len(id) == 0 || !bytes.Equal(header.SignerId, id) {
+		return
+	}
+
+	pubKey, err := crypto.DecodePoint(header.SignerPk)
+	if err != nil {
+		return
+	}
+
+	err = crypto.Verify(*pubKey, signature.GetHashForSigning(b.Header), b.Header.Signature)
+	if err != nil {
+		return
+	}
+
+	e := consensus.evidencePool.AddProposal(header.Height, hex.EncodeToString(header.SignerId), header.SignerPk, b.Hash(), b.Header.GetMessage(), b.Header.Signature)
+	if e != nil {
+		log.Warningf("Node %s signed conflicting proposals %s and %s at height %d", e.NodeID, e.First.BlockHash, e.Second.BlockHash, e.Height)
+	}
+}
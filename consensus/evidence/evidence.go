@@ -0,0 +1,220 @@
+package evidence
+
+import (
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/nknorg/nkn/common"
+)
+
+// Type is the kind of equivocation an evidence proves.
+type Type string
+
+const (
+	// ConflictingVotes is two signed votes with the same height, sequence
+	// number and nonce but different block hashes.
+	ConflictingVotes Type = "conflictingVotes"
+	// ConflictingProposals is two signed block headers with the same height but
+	// different block hashes.
+	ConflictingProposals Type = "conflictingProposals"
+)
+
+// SignedMessage is a message signed by the equivocating node. Signature is
+// over the sha256 hash of Message, so it can be verified with the public key
+// of the evidence alone.
+type SignedMessage struct {
+	BlockHash string `json:"blockHash"`
+	Message   string `json:"message"`
+	Signature string `json:"signature"`
+	Time      int64  `json:"time"`
+}
+
+// Evidence is a pair of conflicting signed messages from the same node.
+type Evidence struct {
+	Type      Type           `json:"type"`
+	Height    uint32         `json:"height"`
+	Seq       uint32         `json:"seq,omitempty"`   // vote sequence number
+	Nonce     uint32         `json:"nonce,omitempty"` // vote nonce of the run of the node
+	NodeID    string         `json:"nodeId"`
+	PublicKey string         `json:"publicKey"`
+	First     *SignedMessage `json:"first"`
+	Second    *SignedMessage `json:"second"`
+	Time      int64          `json:"time"`
+}
+
+type messageKey struct {
+	evidenceType Type
+	height       uint32
+	seq          uint32
+	nonce        uint32
+	publicKey    string
+}
+
+// Pool keeps the signed votes and proposals of the latest heights to detect
+// equivocation, and the evidence found. Only messages from keepHeights heights
+// before the expected height up to maxAhead heights after it are kept, so
+// heights sent by neighbors can not prune the messages of the current height.
+// Evidence beyond maxEvidence replaces the oldest one. Evidence is only found
+// from messages received by the local node and is not shared with other nodes.
+type Pool struct {
+	sync.RWMutex
+	messages       map[messageKey]*SignedMessage
+	reported       map[messageKey]struct{}
+	evidence       []*Evidence
+	countByNode    map[string]int
+	maxEvidence    int
+	keepHeights    uint32
+	maxAhead       uint32
+	expectedHeight uint32
+}
+
+// NewPool creates an evidence pool that keeps signed messages of keepHeights
+// heights up to the expected height and maxAhead heights after it, and at most
+// maxEvidence evidence.
+func NewPool(keepHeights, maxAhead uint32, maxEvidence int) *Pool {
+	return &Pool{
+		messages:    make(map[messageKey]*SignedMessage),
+		reported:    make(map[messageKey]struct{}),
+		evidence:    make([]*Evidence, 0),
+		countByNode: make(map[string]int),
+		maxEvidence: maxEvidence,
+		keepHeights: keepHeights,
+		maxAhead:    maxAhead,
+	}
+}
+
+// SetExpectedHeight sets the consensus height the local node expects and
+// removes signed messages out of the window.
+func (p *Pool) SetExpectedHeight(height uint32) {
+	p.Lock()
+	defer p.Unlock()
+	p.expectedHeight = height
+	p.prune()
+}
+
+// inWindow returns if height is close enough to the expected height to be
+// kept.
+func (p *Pool) inWindow(height uint32) bool {
+	if height > p.expectedHeight {
+		return height-p.expectedHeight <= p.maxAhead
+	}
+	return p.expectedHeight-height < p.keepHeights
+}
+
+// AddVote adds a signed vote of a node and returns the evidence if it
+// conflicts with a previous vote, otherwise nil.
+func (p *Pool) AddVote(height, seq, nonce uint32, nodeID string, publicKey []byte, blockHash common.Uint256, message, signature []byte) *Evidence {
+	return p.add(ConflictingVotes, height, seq, nonce, nodeID, publicKey, blockHash, message, signature)
+}
+
+// AddProposal adds a signed block header and returns the evidence if its
+// signer has signed a different header at the same height, otherwise nil.
+func (p *Pool) AddProposal(height uint32, nodeID string, publicKey []byte, blockHash common.Uint256, message, signature []byte) *Evidence {
+	return p.add(ConflictingProposals, height, 0, 0, nodeID, publicKey, blockHash, message, signature)
+}
+
+func (p *Pool) add(evidenceType Type, height, seq, nonce uint32, nodeID string, publicKey []byte, blockHash common.Uint256, message, signature []byte) *Evidence {
+	p.Lock()
+	defer p.Unlock()
+
+	if !p.inWindow(height) {
+		return nil
+	}
+
+	now := time.Now().UnixNano()
+	msg := &SignedMessage{
+		BlockHash: blockHash.ToHexString(),
+		Message:   hex.EncodeToString(message),
+		Signature: hex.EncodeToString(signature),
+		Time:      now,
+	}
+
+	key := messageKey{
+		evidenceType: evidenceType,
+		height:       height,
+		seq:          seq,
+		nonce:        nonce,
+		publicKey:    hex.EncodeToString(publicKey),
+	}
+
+	prev, ok := p.messages[key]
+	if !ok {
+		p.messages[key] = msg
+		return nil
+	}
+	if prev.BlockHash == msg.BlockHash {
+		return nil
+	}
+	if _, ok := p.reported[key]; ok {
+		return nil
+	}
+	p.reported[key] = struct{}{}
+
+	e := &Evidence{
+		Type:      evidenceType,
+		Height:    height,
+		Seq:       seq,
+		Nonce:     nonce,
+		NodeID:    nodeID,
+		PublicKey: key.publicKey,
+		First:     prev,
+		Second:    msg,
+		Time:      now,
+	}
+
+	if p.maxEvidence > 0 && len(p.evidence) >= p.maxEvidence {
+		p.removeNodeEvidence(p.evidence[0].NodeID)
+		p.evidence = p.evidence[1:]
+	}
+	p.evidence = append(p.evidence, e)
+	p.countByNode[nodeID]++
+
+	return e
+}
+
+// prune removes signed messages out of the window. Caller should hold the
+// lock.
+func (p *Pool) prune() {
+	for key := range p.messages {
+		if !p.inWindow(key.height) {
+			delete(p.messages, key)
+		}
+	}
+	for key := range p.reported {
+		if !p.inWindow(key.height) {
+			delete(p.reported, key)
+		}
+	}
+}
+
+func (p *Pool) removeNodeEvidence(nodeID string) {
+	p.countByNode[nodeID]--
+	if p.countByNode[nodeID] <= 0 {
+		delete(p.countByNode, nodeID)
+	}
+}
+
+// HasEvidence returns if the pool has evidence against a node.
+func (p *Pool) HasEvidence(nodeID string) bool {
+	p.RLock()
+	defer p.RUnlock()
+	return p.countByNode[nodeID] > 0
+}
+
+// Get returns the evidence against a node in the order found, or all evidence
+// if nodeID is empty.
+func (p *Pool) Get(nodeID string) []*Evidence {
+	p.RLock()
+	defer p.RUnlock()
+
+	// evidence is never modified once added
+	evidence := make([]*Evidence, 0)
+	for _, e := range p.evidence {
+		if nodeID == "" || e.NodeID == nodeID {
+			evidence = append(evidence, e)
+		}
+	}
+
+	return evidence
+}
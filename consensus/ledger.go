@@ -0,0 +1,78 @@
+package moca
+
+import (
+	"context"
+
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
+)
+
+// Ledger is the ledger and block validation as seen by consensus. It is
+// implemented over chain.DefaultLedger, and in memory by the simulator.
+type Ledger interface {
+	GetHeight() uint32
+	GetHeaderHashByHeight(height uint32) common.Uint256
+	GetID(publicKey []byte) ([]byte, error)
+	AddBlock(block *block.Block) error
+	CanVerifyHeight(height uint32) bool
+	TimestampCheck(header *block.Header, soft bool) error
+	HeaderCheck(header *block.Header) error
+	NextBlockProposerCheck(header *block.Header) error
+	TransactionCheck(ctx context.Context, block *block.Block) error
+	SignerCheck(header *block.Header) error
+	GetNextBlockSigner(height uint32, timestamp int64) ([]byte, []byte, pb.WinnerType, error)
+	GetNextMiningSigChainTxnHash(height uint32) (common.Uint256, pb.WinnerType, error)
+}
+
+// chainLedger is the Ledger of chain.DefaultLedger
+type chainLedger struct{}
+
+func (chainLedger) GetHeight() uint32 {
+	return chain.DefaultLedger.Store.GetHeight()
+}
+
+func (chainLedger) GetHeaderHashByHeight(height uint32) common.Uint256 {
+	return chain.DefaultLedger.Store.GetHeaderHashByHeight(height)
+}
+
+func (chainLedger) GetID(publicKey []byte) ([]byte, error) {
+	return chain.DefaultLedger.Store.GetID(publicKey)
+}
+
+func (chainLedger) AddBlock(block *block.Block) error {
+	return chain.DefaultLedger.Blockchain.AddBlock(block, false)
+}
+
+func (chainLedger) CanVerifyHeight(height uint32) bool {
+	return chain.CanVerifyHeight(height)
+}
+
+func (chainLedger) TimestampCheck(header *block.Header, soft bool) error {
+	return chain.TimestampCheck(header, soft)
+}
+
+func (chainLedger) HeaderCheck(header *block.Header) error {
+	return chain.HeaderCheck(header)
+}
+
+func (chainLedger) NextBlockProposerCheck(header *block.Header) error {
+	return chain.NextBlockProposerCheck(header)
+}
+
+func (chainLedger) TransactionCheck(ctx context.Context, block *block.Block) error {
+	return chain.TransactionCheck(ctx, block)
+}
+
+func (chainLedger) SignerCheck(header *block.Header) error {
+	return chain.SignerCheck(header)
+}
+
+func (chainLedger) GetNextBlockSigner(height uint32, timestamp int64) ([]byte, []byte, pb.WinnerType, error) {
+	return chain.GetNextBlockSigner(height, timestamp)
+}
+
+func (chainLedger) GetNextMiningSigChainTxnHash(height uint32) (common.Uint256, pb.WinnerType, error) {
+	return chain.GetNextMiningSigChainTxnHash(height)
+}
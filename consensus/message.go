@@ -6,7 +6,6 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/nknorg/nkn/block"
-	"github.com/nknorg/nkn/chain"
 	"github.com/nknorg/nkn/common"
 	"github.com/nknorg/nkn/node"
 	"github.com/nknorg/nkn/pb"
@@ -14,10 +13,12 @@ import (
 )
 
 // NewVoteMessage creates a VOTE message
-func NewVoteMessage(height uint32, blockHash common.Uint256) (*pb.UnsignedMessage, error) {
+func NewVoteMessage(height, seq, nonce uint32, blockHash common.Uint256) (*pb.UnsignedMessage, error) {
 	msgBody := &pb.Vote{
 		Height:    height,
 		BlockHash: blockHash[:],
+		Seq:       seq,
+		Nonce:     nonce,
 	}
 
 	buf, err := proto.Marshal(msgBody)
@@ -201,6 +202,16 @@ func (consensus *Consensus) voteMessageHandler(remoteMessage *node.RemoteMessage
 		return nil, false, err
 	}
 
+	if len(remoteMessage.Signature) == 0 && remoteMessage.Sender.GetProtocolVersion() >= signedVoteProtocolVersion {
+		return nil, false, fmt.Errorf("unsigned vote from neighbor %s with protocol version %d", remoteMessage.Sender.GetID(), remoteMessage.Sender.GetProtocolVersion())
+	}
+
+	if !remoteMessage.Sender.PinVoteNonce(msgBody.Nonce) {
+		return nil, false, fmt.Errorf("vote nonce %d of neighbor %s changed within its session", msgBody.Nonce, remoteMessage.Sender.GetID())
+	}
+
+	consensus.checkVoteEquivocation(remoteMessage, msgBody.Height, msgBody.Seq, msgBody.Nonce, blockHash)
+
 	err = consensus.receiveVote(remoteMessage.Sender.GetID(), msgBody.Height, blockHash)
 	if err != nil {
 		return nil, false, err
@@ -232,8 +243,8 @@ func (consensus *Consensus) iHaveBlockProposalMessageHandler(remoteMessage *node
 
 // getConsensusStateMessageHandler handles a GET_CONSENSUS_STATE message
 func (consensus *Consensus) getConsensusStateMessageHandler(remoteMessage *node.RemoteMessage) ([]byte, bool, error) {
-	ledgerHeight := chain.DefaultLedger.Store.GetHeight()
-	ledgerBlockHash := chain.DefaultLedger.Store.GetHeaderHashByHeight(ledgerHeight)
+	ledgerHeight := consensus.ledger.GetHeight()
+	ledgerBlockHash := consensus.ledger.GetHeaderHashByHeight(ledgerHeight)
 	consensusHeight := consensus.GetExpectedHeight()
 	syncState := consensus.localNode.GetSyncState()
 	minVerifiableHeight := consensus.localNode.GetMinVerifiableHeight()
@@ -11,13 +11,10 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/nknorg/nkn/block"
-	"github.com/nknorg/nkn/chain"
 	"github.com/nknorg/nkn/common"
 	"github.com/nknorg/nkn/consensus/election"
 	"github.com/nknorg/nkn/crypto"
-	"github.com/nknorg/nkn/node"
 	"github.com/nknorg/nkn/pb"
-	"github.com/nknorg/nkn/por"
 	"github.com/nknorg/nkn/transaction"
 	"github.com/nknorg/nkn/util/config"
 	"github.com/nknorg/nkn/util/log"
@@ -46,7 +43,7 @@ func (consensus *Consensus) getBlockProposal(blockHash common.Uint256) (*block.B
 }
 
 func (consensus *Consensus) canVerifyHeight(height uint32) bool {
-	return chain.CanVerifyHeight(height) && height >= consensus.localNode.GetMinVerifiableHeight()
+	return consensus.ledger.CanVerifyHeight(height) && height >= consensus.localNode.GetMinVerifiableHeight()
 }
 
 // waitAndHandleProposal waits for first valid proposal, and continues to handle
@@ -54,9 +51,9 @@ func (consensus *Consensus) canVerifyHeight(height uint32) bool {
 func (consensus *Consensus) waitAndHandleProposal() (*election.Election, error) {
 	var timerStartOnce sync.Once
 	var deadline time.Time
-	electionStartTimer := time.NewTimer(math.MaxInt64)
+	electionStartTimer := consensus.clock.NewTimer(math.MaxInt64)
 	electionStartTimer.Stop()
-	timeoutTimer := time.NewTimer(electionStartDelay)
+	timeoutTimer := consensus.clock.NewTimer(electionStartDelay)
 	proposals := make(map[common.Uint256]*block.Block)
 
 	consensus.proposalLock.RLock()
@@ -78,16 +75,16 @@ func (consensus *Consensus) waitAndHandleProposal() (*election.Election, error)
 			timerStartOnce.Do(func() {
 				timer.StopTimer(timeoutTimer)
 				electionStartTimer.Reset(electionStartDelay)
-				deadline = time.Now().Add(proposalVerificationTimeout)
+				deadline = consensus.clock.Now().Add(proposalVerificationTimeout)
 			})
 			break
 		}
 
 		select {
-		case <-timeoutTimer.C:
+		case <-timeoutTimer.C():
 			return nil, errors.New("Wait for neighbor vote timeout")
 		default:
-			time.Sleep(50 * time.Millisecond)
+			consensus.clock.Sleep(50 * time.Millisecond)
 		}
 	}
 
@@ -107,7 +104,7 @@ func (consensus *Consensus) waitAndHandleProposal() (*election.Election, error)
 			timerStartOnce.Do(func() {
 				timer.StopTimer(timeoutTimer)
 				electionStartTimer.Reset(electionStartDelay)
-				deadline = time.Now().Add(proposalVerificationTimeout)
+				deadline = consensus.clock.Now().Add(proposalVerificationTimeout)
 			})
 
 			acceptProposal := true
@@ -123,29 +120,36 @@ func (consensus *Consensus) waitAndHandleProposal() (*election.Election, error)
 				log.Errorf("Send I have block message error: %v", err)
 			}
 
+			var rejectReason error
 			if len(proposals) > 1 {
 				log.Warningf("Received multiple different proposals, rejecting all of them")
 				acceptProposal = false
+				rejectReason = errors.New("multiple different proposals")
 			}
 
 			ctx, cancel := context.WithDeadline(context.Background(), deadline)
 			defer cancel()
 
 			if acceptProposal {
-				if err = chain.TimestampCheck(proposal.Header, true); err != nil {
+				if err = consensus.ledger.TimestampCheck(proposal.Header, true); err != nil {
 					log.Warningf("Proposal fails to pass soft timestamp check: %v", err)
 					acceptProposal = false
-				} else if err = chain.HeaderCheck(proposal.Header); err != nil {
+				} else if err = consensus.ledger.HeaderCheck(proposal.Header); err != nil {
 					log.Warningf("Proposal fails to pass header check: %v", err)
 					acceptProposal = false
-				} else if err = chain.NextBlockProposerCheck(proposal.Header); err != nil {
+				} else if err = consensus.ledger.NextBlockProposerCheck(proposal.Header); err != nil {
 					log.Warningf("Proposal fails to pass next block proposal check: %v", err)
 					acceptProposal = false
-				} else if err = chain.TransactionCheck(ctx, proposal); err != nil {
+				} else if err = consensus.ledger.TransactionCheck(ctx, proposal); err != nil {
 					log.Warningf("Proposal fails to pass transaction check: %v", err)
 					acceptProposal = false
 				}
+				if !acceptProposal {
+					rejectReason = err
+				}
+				consensus.updateProposalReputation(proposal.Header, acceptProposal)
 			}
+			consensus.trace.VerifyProposal(consensusHeight, blockHash, rejectReason)
 
 			initialVote := common.EmptyUint256
 			if acceptProposal {
@@ -153,6 +157,7 @@ func (consensus *Consensus) waitAndHandleProposal() (*election.Election, error)
 			}
 
 			elc.SetInitialVote(initialVote)
+			consensus.trace.SelfVote(consensusHeight, initialVote)
 
 			err = consensus.vote(consensusHeight, initialVote)
 			if err != nil {
@@ -160,15 +165,15 @@ func (consensus *Consensus) waitAndHandleProposal() (*election.Election, error)
 			}
 
 			select {
-			case <-electionStartTimer.C:
+			case <-electionStartTimer.C():
 				return elc, nil
 			default:
 			}
 
-		case <-electionStartTimer.C:
+		case <-electionStartTimer.C():
 			return elc, nil
 
-		case <-timeoutTimer.C:
+		case <-timeoutTimer.C():
 			return nil, errors.New("Wait for proposal timeout")
 		}
 	}
@@ -234,6 +239,8 @@ func (consensus *Consensus) receiveProposal(block *block.Block) error {
 		return fmt.Errorf("Receive invalid proposal height %d instead of %d", receivedHeight, expectedHeight)
 	}
 
+	consensus.checkProposalEquivocation(block)
+
 	select {
 	case consensus.proposalChan <- block:
 	default:
@@ -241,6 +248,7 @@ func (consensus *Consensus) receiveProposal(block *block.Block) error {
 	}
 
 	consensus.proposals.Set(blockHash.ToArray(), block)
+	consensus.trace.ReceiveProposal(receivedHeight, blockHash, block.Header.UnsignedHeader.SignerPk, len(block.Transactions))
 
 	return nil
 }
@@ -282,7 +290,7 @@ func (consensus *Consensus) receiveProposalHash(neighborID string, height uint32
 
 // requestProposal requests a block proposal by block hash from a neighbor using
 // REQUEST_BLOCK_PROPOSAL message
-func (consensus *Consensus) requestProposal(neighbor *node.RemoteNode, blockHash common.Uint256, height uint32, requestType pb.RequestTransactionType) (*block.Block, error) {
+func (consensus *Consensus) requestProposal(neighbor Neighbor, blockHash common.Uint256, height uint32, requestType pb.RequestTransactionType) (*block.Block, error) {
 	var shortHashSalt []byte
 	var shortHashSize uint32
 	if requestType == pb.REQUEST_TRANSACTION_SHORT_HASH {
@@ -326,11 +334,11 @@ func (consensus *Consensus) requestProposal(neighbor *node.RemoteNode, blockHash
 	if consensus.canVerifyHeight(b.Header.UnsignedHeader.Height) {
 		// We put hard timestamp check here to prevent proposal with invalid
 		// timestamp to be propagated
-		if err = chain.TimestampCheck(b.Header, false); err != nil {
+		if err = consensus.ledger.TimestampCheck(b.Header, false); err != nil {
 			consensus.proposals.Set(blockHash.ToArray(), b)
 			return nil, fmt.Errorf("Proposal fails to pass hard timestamp check: %v", err)
 		}
-		if err = chain.SignerCheck(b.Header); err != nil {
+		if err = consensus.ledger.SignerCheck(b.Header); err != nil {
 			consensus.proposals.Set(blockHash.ToArray(), b)
 			return nil, fmt.Errorf("Proposal fails to pass signer check: %v", err)
 		}
@@ -376,9 +384,7 @@ func (consensus *Consensus) requestProposal(neighbor *node.RemoteNode, blockHash
 		}
 
 		for i := range txnsHash {
-			if txn := consensus.localNode.TxnPool.GetTxnByHash(txnsHash[i]); txn != nil {
-				poolTxns = append(poolTxns, txn)
-			} else if txn, err = por.GetPorServer().GetSigChainTxn(txnsHash[i]); err == nil && txn != nil {
+			if txn := consensus.localNode.GetTxnByHash(txnsHash[i]); txn != nil {
 				poolTxns = append(poolTxns, txn)
 			} else {
 				missingTxnsHash = append(missingTxnsHash, txnsHash[i].ToArray())
@@ -386,9 +392,7 @@ func (consensus *Consensus) requestProposal(neighbor *node.RemoteNode, blockHash
 		}
 	case pb.REQUEST_TRANSACTION_SHORT_HASH:
 		for i := range replyMsg.TransactionsHash {
-			if txn := consensus.localNode.TxnPool.GetTxnByShortHash(replyMsg.TransactionsHash[i]); txn != nil {
-				poolTxns = append(poolTxns, txn)
-			} else if txn, err = por.GetPorServer().GetSigChainTxnByShortHash(replyMsg.TransactionsHash[i]); err == nil && txn != nil {
+			if txn := consensus.localNode.GetTxnByShortHash(replyMsg.TransactionsHash[i]); txn != nil {
 				poolTxns = append(poolTxns, txn)
 			} else {
 				missingTxnsHash = append(missingTxnsHash, replyMsg.TransactionsHash[i])
@@ -492,7 +496,7 @@ func mergeTxns(poolTxns, requestedTxns, mergedTxns []*transaction.Transaction, t
 	return nil
 }
 
-func (consensus *Consensus) requestProposalTransactions(neighbor *node.RemoteNode, blockHash common.Uint256, requestType pb.RequestTransactionType, txnsHash [][]byte) ([]*transaction.Transaction, error) {
+func (consensus *Consensus) requestProposalTransactions(neighbor Neighbor, blockHash common.Uint256, requestType pb.RequestTransactionType, txnsHash [][]byte) ([]*transaction.Transaction, error) {
 	var shortHashSalt []byte
 	var shortHashSize uint32
 	if requestType == pb.REQUEST_TRANSACTION_SHORT_HASH {
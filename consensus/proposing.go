@@ -3,10 +3,8 @@ package moca
 import (
 	"bytes"
 	"context"
-	"time"
 
 	"github.com/nknorg/nkn/block"
-	"github.com/nknorg/nkn/chain"
 	"github.com/nknorg/nkn/util/config"
 	"github.com/nknorg/nkn/util/log"
 	"github.com/nknorg/nkn/util/timer"
@@ -18,13 +16,13 @@ func (consensus *Consensus) startProposing() {
 	var timestamp int64
 	var ctx context.Context
 	var cancel context.CancelFunc
-	proposingTimer := time.NewTimer(proposingStartDelay)
+	proposingTimer := consensus.clock.NewTimer(proposingStartDelay)
 	for {
 		select {
-		case <-proposingTimer.C:
-			currentHeight = chain.DefaultLedger.Store.GetHeight()
+		case <-proposingTimer.C():
+			currentHeight = consensus.ledger.GetHeight()
 			expectedHeight = consensus.GetExpectedHeight()
-			timestamp = time.Now().Unix()
+			timestamp = consensus.clock.Now().Unix()
 			if config.Parameters.Mining && expectedHeight > lastProposedHeight && expectedHeight == currentHeight+1 && consensus.isBlockProposer(currentHeight, timestamp) {
 				log.Infof("I am the block proposer at height %d", expectedHeight)
 
@@ -44,7 +42,7 @@ func (consensus *Consensus) startProposing() {
 				consensus.localNode.IncrementProposalSubmitted()
 
 				// Prevent neighbor from receiving proposal before last consensus stops
-				time.Sleep(proposalPropagationDelay)
+				consensus.clock.Sleep(proposalPropagationDelay)
 
 				err = consensus.receiveProposal(block)
 				if err != nil {
@@ -62,7 +60,7 @@ func (consensus *Consensus) startProposing() {
 // isBlockProposer returns if local node is the block proposer of block height+1
 // at a given timestamp
 func (consensus *Consensus) isBlockProposer(height uint32, timestamp int64) bool {
-	nextPublicKey, nextChordID, _, err := chain.GetNextBlockSigner(height, timestamp)
+	nextPublicKey, nextChordID, _, err := consensus.ledger.GetNextBlockSigner(height, timestamp)
 	if err != nil {
 		log.Errorf("Get next block signer error: %v", err)
 		return false
@@ -83,7 +81,7 @@ func (consensus *Consensus) isBlockProposer(height uint32, timestamp int64) bool
 
 // proposeBlock proposes a new block at give height and timestamp
 func (consensus *Consensus) proposeBlock(ctx context.Context, height uint32, timestamp int64) (*block.Block, error) {
-	winnerHash, winnerType, err := chain.GetNextMiningSigChainTxnHash(height)
+	winnerHash, winnerType, err := consensus.ledger.GetNextMiningSigChainTxnHash(height)
 	if err != nil {
 		return nil, err
 	}
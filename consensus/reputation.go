@@ -0,0 +1,127 @@
+package moca
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/consensus/election"
+	"github.com/nknorg/nkn/util/config"
+)
+
+// voteWeight maps a reputation score between 0 and 1 to a vote weight between
+// MinVoteWeight and MaxVoteWeight.
+func voteWeight(score float64) uint32 {
+	minWeight, maxWeight := config.Parameters.MinVoteWeight, config.Parameters.MaxVoteWeight
+	if score <= 0 {
+		return minWeight
+	}
+	if score >= 1 {
+		return maxWeight
+	}
+	return minWeight + uint32(score*float64(maxWeight-minWeight)+0.5)
+}
+
+// getNeighborWeight returns the vote weight of a neighbor by its reputation, or
+// of the local node if neighborID is nil. Neighbors with equivocation evidence
+// get no weight.
+func (consensus *Consensus) getNeighborWeight(neighborID interface{}) uint32 {
+	if neighborID == nil {
+		return consensus.getSelfWeight()
+	}
+
+	id, ok := neighborID.(string)
+	if !ok {
+		return config.Parameters.MinVoteWeight
+	}
+
+	if consensus.evidencePool.HasEvidence(id) {
+		return 0
+	}
+
+	neighbor := consensus.localNode.GetNbrNode(id)
+	if neighbor == nil {
+		return config.Parameters.MinVoteWeight
+	}
+
+	return voteWeight(neighbor.GetReputation().Score)
+}
+
+// getSelfWeight returns the average vote weight of neighbors without
+// equivocation evidence, so that the vote of the local node counts as much as
+// a typical neighbor. Returns MinVoteWeight if there is no such neighbor.
+func (consensus *Consensus) getSelfWeight() uint32 {
+	var total, count uint64
+	for _, neighbor := range consensus.localNode.GetNeighbors(nil) {
+		if consensus.evidencePool.HasEvidence(neighbor.GetID()) {
+			continue
+		}
+		total += uint64(voteWeight(neighbor.GetReputation().Score))
+		count++
+	}
+
+	if count == 0 {
+		return config.Parameters.MinVoteWeight
+	}
+
+	return uint32((total + count/2) / count)
+}
+
+// updateVoteReputation updates the reputation of neighbors by whether their
+// votes agreed with the blocks persisted since the last update. Votes are
+// scored against the persisted block rather than the local election result,
+// which can be wrong if the local node synced a different block. Stops at an
+// election that is still running so it is scored once it stops.
+func (consensus *Consensus) updateVoteReputation() {
+	ledgerHeight := consensus.ledger.GetHeight()
+	for height := consensus.voteScoredHeight + 1; height <= ledgerHeight; height++ {
+		consensus.electionsLock.RLock()
+		value, ok := consensus.elections.Get(heightToKey(height))
+		consensus.electionsLock.RUnlock()
+		if ok && value != nil {
+			elc, ok := value.(*election.Election)
+			if ok && elc != nil {
+				if elc.HasStarted() && !elc.IsStopped() {
+					return
+				}
+				consensus.scoreVotes(elc, consensus.ledger.GetHeaderHashByHeight(height))
+			}
+		}
+		consensus.voteScoredHeight = height
+	}
+}
+
+// scoreVotes updates the reputation of neighbors by whether their votes in an
+// election agreed with the persisted block.
+func (consensus *Consensus) scoreVotes(elc *election.Election, blockHash common.Uint256) {
+	for neighborID, vote := range elc.GetNeighborVotes() {
+		id, ok := neighborID.(string)
+		if !ok {
+			continue
+		}
+		neighbor := consensus.localNode.GetNbrNode(id)
+		if neighbor == nil {
+			continue
+		}
+		neighbor.UpdateVoteAgreement(vote == blockHash)
+	}
+}
+
+// updateProposalReputation updates the reputation of the neighbor that signed
+// a proposal by whether the proposal passed verification. Neighbors only relay
+// proposals, so the signer is held responsible. Nothing is updated if the
+// signer is not a neighbor, or the signer ID does not belong to the signer
+// public key.
+func (consensus *Consensus) updateProposalReputation(header *block.Header, valid bool) {
+	neighbor := consensus.localNode.GetNbrNode(hex.EncodeToString(header.UnsignedHeader.SignerId))
+	if neighbor == nil {
+		return
+	}
+
+	if !bytes.Equal(neighbor.GetPubKey().EncodePoint(), header.UnsignedHeader.SignerPk) {
+		return
+	}
+
+	neighbor.UpdateProposalValidity(valid)
+}
@@ -0,0 +1,225 @@
+package moca
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/consensus/election"
+	"github.com/nknorg/nkn/consensus/evidence"
+	"github.com/nknorg/nkn/crypto"
+	"github.com/nknorg/nkn/node"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/vault"
+)
+
+type testNeighbor struct {
+	Neighbor
+	id             string
+	pubKey         *crypto.PubKey
+	score          float64
+	votesAgreed    []bool
+	proposalsValid []bool
+}
+
+func (n *testNeighbor) GetID() string {
+	return n.id
+}
+
+func (n *testNeighbor) GetPubKey() *crypto.PubKey {
+	return n.pubKey
+}
+
+func (n *testNeighbor) GetReputation() *node.ReputationInfo {
+	return &node.ReputationInfo{Score: n.score}
+}
+
+func (n *testNeighbor) UpdateVoteAgreement(agreed bool) {
+	n.votesAgreed = append(n.votesAgreed, agreed)
+}
+
+func (n *testNeighbor) UpdateProposalValidity(valid bool) {
+	n.proposalsValid = append(n.proposalsValid, valid)
+}
+
+type testTransport struct {
+	Transport
+	neighbors []*testNeighbor
+}
+
+func (t *testTransport) GetNbrNode(id string) Neighbor {
+	for _, n := range t.neighbors {
+		if n.id == id {
+			return n
+		}
+	}
+	return nil
+}
+
+func (t *testTransport) GetNeighbors(filter func(Neighbor) bool) []Neighbor {
+	neighbors := make([]Neighbor, 0, len(t.neighbors))
+	for _, n := range t.neighbors {
+		if filter == nil || filter(n) {
+			neighbors = append(neighbors, n)
+		}
+	}
+	return neighbors
+}
+
+type testLedger struct {
+	Ledger
+	hashes []common.Uint256
+}
+
+func (l *testLedger) GetHeight() uint32 {
+	return uint32(len(l.hashes) - 1)
+}
+
+func (l *testLedger) GetHeaderHashByHeight(height uint32) common.Uint256 {
+	return l.hashes[height]
+}
+
+func newTestConsensus(neighbors ...*testNeighbor) *Consensus {
+	return &Consensus{
+		localNode:    &testTransport{neighbors: neighbors},
+		evidencePool: evidence.NewPool(evidenceKeepHeights, evidenceFutureHeights, maxEvidence),
+		elections:    common.NewGoCache(cacheExpiration, cacheCleanupInterval),
+	}
+}
+
+// addEquivocation adds conflicting proposals of a node to the evidence pool.
+func addEquivocation(pool *evidence.Pool, nodeID string) {
+	pool.AddProposal(1, nodeID, []byte(nodeID), common.Uint256{1}, nil, nil)
+	pool.AddProposal(1, nodeID, []byte(nodeID), common.Uint256{2}, nil, nil)
+}
+
+func TestVoteWeight(t *testing.T) {
+	minWeight, maxWeight := config.Parameters.MinVoteWeight, config.Parameters.MaxVoteWeight
+	defer func() {
+		config.Parameters.MinVoteWeight, config.Parameters.MaxVoteWeight = minWeight, maxWeight
+	}()
+	config.Parameters.MinVoteWeight, config.Parameters.MaxVoteWeight = 1, 10
+
+	tests := []struct {
+		score  float64
+		weight uint32
+	}{
+		{-1, 1},
+		{0, 1},
+		{0.04, 1},
+		{0.06, 2},
+		{0.5, 6},
+		{0.95, 10},
+		{1, 10},
+		{2, 10},
+	}
+	for _, test := range tests {
+		if weight := voteWeight(test.score); weight != test.weight {
+			t.Errorf("score %v: got weight %d, want %d", test.score, weight, test.weight)
+		}
+	}
+}
+
+func TestGetSelfWeight(t *testing.T) {
+	consensus := newTestConsensus()
+	if weight := consensus.getSelfWeight(); weight != config.Parameters.MinVoteWeight {
+		t.Fatalf("got weight %d without neighbors, want %d", weight, config.Parameters.MinVoteWeight)
+	}
+
+	consensus = newTestConsensus(
+		&testNeighbor{id: "a", score: 0},
+		&testNeighbor{id: "b", score: 1},
+		&testNeighbor{id: "c", score: 1},
+	)
+	want := (voteWeight(0) + 2*voteWeight(1) + 1) / 3
+	if weight := consensus.getSelfWeight(); weight != want {
+		t.Fatalf("got weight %d, want %d", weight, want)
+	}
+
+	// neighbors with evidence are left out of the average and get no weight
+	addEquivocation(consensus.evidencePool, "a")
+	if weight := consensus.getSelfWeight(); weight != voteWeight(1) {
+		t.Fatalf("got weight %d, want %d", weight, voteWeight(1))
+	}
+	if weight := consensus.getNeighborWeight("a"); weight != 0 {
+		t.Fatalf("got weight %d of neighbor with evidence, want 0", weight)
+	}
+
+	addEquivocation(consensus.evidencePool, "b")
+	addEquivocation(consensus.evidencePool, "c")
+	if weight := consensus.getSelfWeight(); weight != config.Parameters.MinVoteWeight {
+		t.Fatalf("got weight %d with evidence against all neighbors, want %d", weight, config.Parameters.MinVoteWeight)
+	}
+}
+
+func TestUpdateVoteReputation(t *testing.T) {
+	a, b := &testNeighbor{id: "a"}, &testNeighbor{id: "b"}
+	consensus := newTestConsensus(a, b)
+	ledger := &testLedger{hashes: []common.Uint256{{0}}}
+	consensus.ledger = ledger
+
+	// the local node elected block 1, but block 2 is persisted
+	elc, err := election.NewElection(&election.Config{Duration: electionDuration})
+	if err != nil {
+		t.Fatal(err)
+	}
+	elc.ReceiveVote("a", common.Uint256{1})
+	elc.ReceiveVote("b", common.Uint256{2})
+	consensus.elections.Set(heightToKey(1), elc)
+
+	consensus.updateVoteReputation()
+	if len(a.votesAgreed) != 0 || len(b.votesAgreed) != 0 {
+		t.Fatal("votes should not be scored before the block is persisted")
+	}
+
+	ledger.hashes = append(ledger.hashes, common.Uint256{2})
+	consensus.updateVoteReputation()
+	consensus.updateVoteReputation()
+	if len(a.votesAgreed) != 1 || a.votesAgreed[0] {
+		t.Fatalf("got vote agreement %v of a, want [false]", a.votesAgreed)
+	}
+	if len(b.votesAgreed) != 1 || !b.votesAgreed[0] {
+		t.Fatalf("got vote agreement %v of b, want [true]", b.votesAgreed)
+	}
+}
+
+func TestUpdateProposalReputation(t *testing.T) {
+	signer, err := vault.NewAccount()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := vault.NewAccount()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signerID := []byte{1}
+	relay := &testNeighbor{id: "relay", pubKey: other.PublicKey}
+	n := &testNeighbor{id: hex.EncodeToString(signerID), pubKey: signer.PublicKey}
+	consensus := newTestConsensus(relay, n)
+
+	header := &block.Header{
+		Header: &pb.Header{
+			UnsignedHeader: &pb.UnsignedHeader{
+				SignerPk: signer.PublicKey.EncodePoint(),
+				SignerId: signerID,
+			},
+		},
+	}
+	consensus.updateProposalReputation(header, false)
+	if len(n.proposalsValid) != 1 || n.proposalsValid[0] {
+		t.Fatalf("got proposal validity %v of signer, want [false]", n.proposalsValid)
+	}
+	if len(relay.proposalsValid) != 0 {
+		t.Fatal("proposal validity should not be attributed to a relay")
+	}
+
+	// signer ID of a neighbor with a different public key
+	header.UnsignedHeader.SignerPk = other.PublicKey.EncodePoint()
+	consensus.updateProposalReputation(header, false)
+	if len(n.proposalsValid) != 1 || len(relay.proposalsValid) != 0 {
+		t.Fatal("proposal validity should not be attributed if signer ID and public key mismatch")
+	}
+}
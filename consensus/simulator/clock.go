@@ -0,0 +1,217 @@
+package simulator
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"runtime"
+	"runtime/metrics"
+	"sync"
+	"time"
+
+	"github.com/nknorg/nkn/util/timer"
+)
+
+// epoch is the time virtual clocks start at
+var epoch = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// busyMetrics are the goroutines that are not blocked on a channel or lock
+var busyMetrics = []string{
+	"/sched/goroutines/running:goroutines",
+	"/sched/goroutines/runnable:goroutines",
+	"/sched/goroutines/not-in-go:goroutines",
+}
+
+type event struct {
+	at       time.Duration
+	seq      uint64
+	fn       func()
+	timer    *simTimer
+	canceled bool
+}
+
+// eventQueue is a min heap of events ordered by time, then by the order they
+// are scheduled, so events at the same time run in a fixed order.
+type eventQueue []*event
+
+func (q eventQueue) Len() int { return len(q) }
+
+func (q eventQueue) Less(i, j int) bool {
+	if q[i].at != q[j].at {
+		return q[i].at < q[j].at
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q eventQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *eventQueue) Push(x interface{}) { *q = append(*q, x.(*event)) }
+
+func (q *eventQueue) Pop() interface{} {
+	old := *q
+	e := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return e
+}
+
+// clock is a virtual timer.Clock. Time only advances when the simulator runs
+// the next event, which it does once all nodes are blocked.
+type clock struct {
+	sync.Mutex
+	now   time.Duration
+	seq   uint64
+	queue eventQueue
+}
+
+// elapsed returns the virtual time since the clock started.
+func (c *clock) elapsed() time.Duration {
+	c.Lock()
+	defer c.Unlock()
+	return c.now
+}
+
+// schedule schedules fn to run after d of virtual time. Caller should hold the
+// lock.
+func (c *clock) schedule(d time.Duration, fn func(), t *simTimer) *event {
+	if d < 0 {
+		d = 0
+	}
+	at := c.now + d
+	if at < c.now {
+		at = math.MaxInt64
+	}
+	c.seq++
+	e := &event{at: at, seq: c.seq, fn: fn, timer: t}
+	heap.Push(&c.queue, e)
+	return e
+}
+
+// after schedules fn to run after d of virtual time.
+func (c *clock) after(d time.Duration, fn func()) {
+	c.Lock()
+	c.schedule(d, fn, nil)
+	c.Unlock()
+}
+
+// step runs the next event. Returns false if no event is left or the next one
+// is after deadline.
+func (c *clock) step(deadline time.Duration) bool {
+	c.Lock()
+	for len(c.queue) > 0 && c.queue[0].canceled {
+		heap.Pop(&c.queue)
+	}
+	if len(c.queue) == 0 || c.queue[0].at > deadline {
+		c.Unlock()
+		return false
+	}
+	e := heap.Pop(&c.queue).(*event)
+	c.now = e.at
+	if e.timer != nil {
+		e.timer.event = nil
+	}
+	c.Unlock()
+
+	e.fn()
+
+	return true
+}
+
+func (c *clock) Now() time.Time {
+	return epoch.Add(c.elapsed())
+}
+
+func (c *clock) Sleep(d time.Duration) {
+	done := make(chan struct{})
+	c.after(d, func() {
+		close(done)
+	})
+	<-done
+}
+
+func (c *clock) NewTimer(d time.Duration) timer.Timer {
+	t := &simTimer{clock: c, c: make(chan time.Time, 1)}
+	t.fn = func() {
+		select {
+		case t.c <- c.Now():
+		default:
+		}
+	}
+	t.Reset(d)
+	return t
+}
+
+func (c *clock) AfterFunc(d time.Duration, f func()) timer.Timer {
+	t := &simTimer{clock: c}
+	t.fn = func() {
+		go f()
+	}
+	t.Reset(d)
+	return t
+}
+
+// simTimer is a timer of the virtual clock
+type simTimer struct {
+	clock *clock
+	c     chan time.Time
+	fn    func()
+	event *event // nil if the timer is not pending
+}
+
+func (t *simTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *simTimer) Stop() bool {
+	t.clock.Lock()
+	defer t.clock.Unlock()
+	return t.stop()
+}
+
+// stop cancels the pending event of the timer. Caller should hold the clock
+// lock.
+func (t *simTimer) stop() bool {
+	if t.event == nil {
+		return false
+	}
+	t.event.canceled = true
+	t.event = nil
+	return true
+}
+
+func (t *simTimer) Reset(d time.Duration) bool {
+	t.clock.Lock()
+	defer t.clock.Unlock()
+	active := t.stop()
+	t.event = t.clock.schedule(d, t.fn, t)
+	return active
+}
+
+// waitIdle returns once no goroutine other than the caller is running or
+// ready to run, so that all nodes have handled the last event and wait for
+// the clock or the network. Idleness is read from the scheduler metrics and
+// confirmed after yielding again, as a goroutine may be just woken up.
+func waitIdle() {
+	samples := make([]metrics.Sample, len(busyMetrics))
+	for i, name := range busyMetrics {
+		samples[i].Name = name
+	}
+
+	for idle := 0; idle < 2; {
+		runtime.Gosched()
+		metrics.Read(samples)
+
+		var busy uint64
+		for _, sample := range samples {
+			if sample.Value.Kind() != metrics.KindUint64 {
+				panic(fmt.Errorf("metric %s is not supported by the runtime", sample.Name))
+			}
+			busy += sample.Value.Uint64()
+		}
+
+		if busy > 1 {
+			idle = 0
+		} else {
+			idle++
+		}
+	}
+}
@@ -0,0 +1,345 @@
+package simulator
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/crypto"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/signature"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+)
+
+// Decision is the block a node added to its ledger at a height.
+type Decision struct {
+	Hash   common.Uint256
+	Time   time.Duration
+	Round  uint32 // proposer changes since the previous block before the block was proposed
+	Synced bool   // synced from a neighbor instead of elected
+}
+
+// proposingRound returns the round of a block proposed at timestamp after a
+// block at prevTimestamp, or false if no block can be proposed at timestamp.
+// Time windows are the ones of chain.GetNextBlockSigner: the proposer of
+// round 0 proposes after the consensus duration, and the proposer changes
+// every consensus timeout.
+func proposingRound(prevTimestamp, timestamp int64) (uint32, bool) {
+	timeSinceLastBlock := timestamp - prevTimestamp
+	duration := int64(config.ConsensusDuration.Seconds())
+	proposerChangeTime := int64(config.ConsensusTimeout.Seconds())
+	tolerance := int64(chain.ProposingTimeTolerance.Seconds())
+
+	if timeSinceLastBlock >= proposerChangeTime {
+		if timeSinceLastBlock%proposerChangeTime > tolerance {
+			return 0, false
+		}
+		return uint32(timeSinceLastBlock / proposerChangeTime), true
+	}
+
+	if timeSinceLastBlock < duration || timeSinceLastBlock > duration+tolerance {
+		return 0, false
+	}
+
+	return 0, true
+}
+
+// newGenesisBlock returns the genesis block shared by all nodes. It is
+// timestamped so that the first block is due when consensus starts proposing.
+func newGenesisBlock() *block.Block {
+	timestamp := epoch.Add(config.ConsensusTimeout - config.ConsensusDuration)
+	return &block.Block{
+		Header: &block.Header{
+			Header: &pb.Header{
+				UnsignedHeader: &pb.UnsignedHeader{
+					Version:       config.HeaderVersion,
+					PrevBlockHash: common.EmptyUint256.ToArray(),
+					Timestamp:     timestamp.Unix(),
+					WinnerType:    pb.GENESIS_SIGNER,
+				},
+			},
+		},
+	}
+}
+
+// ledger is the in-memory chain of a simulated node. Blocks are only checked
+// for what consensus relies on: height, previous block, timestamp and signer.
+// Block hashes are computed once when blocks are added, as block.Header caches
+// its hash without locking.
+type ledger struct {
+	sync.RWMutex
+	sim       *Simulator
+	blocks    []*block.Block
+	hashes    []common.Uint256
+	decisions map[uint32]*Decision
+}
+
+func newLedger(sim *Simulator) *ledger {
+	return &ledger{
+		sim:       sim,
+		blocks:    []*block.Block{sim.genesis},
+		hashes:    []common.Uint256{sim.genesis.Hash()},
+		decisions: make(map[uint32]*Decision),
+	}
+}
+
+func (l *ledger) GetHeight() uint32 {
+	l.RLock()
+	defer l.RUnlock()
+	return uint32(len(l.blocks) - 1)
+}
+
+func (l *ledger) GetHeaderHashByHeight(height uint32) common.Uint256 {
+	l.RLock()
+	defer l.RUnlock()
+	if int(height) >= len(l.hashes) {
+		return common.EmptyUint256
+	}
+	return l.hashes[height]
+}
+
+// getHeader returns the header at height.
+func (l *ledger) getHeader(height uint32) (*block.Header, error) {
+	l.RLock()
+	defer l.RUnlock()
+	if int(height) >= len(l.blocks) {
+		return nil, fmt.Errorf("Height %d is higher than current height %d", height, len(l.blocks)-1)
+	}
+	return l.blocks[height].Header, nil
+}
+
+// getBlocks returns the serialized blocks from startHeight to stopHeight, or
+// less if the ledger is not that high.
+func (l *ledger) getBlocks(startHeight, stopHeight uint32) ([][]byte, error) {
+	l.RLock()
+	defer l.RUnlock()
+	blocks := make([][]byte, 0)
+	for height := startHeight; height <= stopHeight && int(height) < len(l.blocks); height++ {
+		buf, err := l.blocks[height].Marshal()
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, buf)
+	}
+	return blocks, nil
+}
+
+func (l *ledger) GetID(publicKey []byte) ([]byte, error) {
+	for _, n := range l.sim.nodes {
+		if bytes.Equal(n.publicKey, publicKey) {
+			return n.GetChordID(), nil
+		}
+	}
+	return nil, fmt.Errorf("ID of %x not found", publicKey)
+}
+
+func (l *ledger) AddBlock(b *block.Block) error {
+	return l.addBlock(b, false)
+}
+
+// addBlock appends a block to the ledger and records the decision.
+func (l *ledger) addBlock(b *block.Block, synced bool) error {
+	l.Lock()
+	defer l.Unlock()
+
+	header := b.Header.UnsignedHeader
+	prevHeader := l.blocks[len(l.blocks)-1].Header
+	if int(header.Height) != len(l.blocks) {
+		return fmt.Errorf("block height %d is different from expected height %d", header.Height, len(l.blocks))
+	}
+	prevHash := l.hashes[len(l.hashes)-1]
+	if !bytes.Equal(header.PrevBlockHash, prevHash.ToArray()) {
+		return fmt.Errorf("invalid prev header %x, expecting %x", header.PrevBlockHash, prevHash.ToArray())
+	}
+
+	hash := b.Hash()
+	round, _ := proposingRound(prevHeader.UnsignedHeader.Timestamp, header.Timestamp)
+	l.blocks = append(l.blocks, b)
+	l.hashes = append(l.hashes, hash)
+	l.decisions[header.Height] = &Decision{
+		Hash:   hash,
+		Time:   l.sim.clock.elapsed(),
+		Round:  round,
+		Synced: synced,
+	}
+
+	return nil
+}
+
+// getDecisions returns the decisions of all heights.
+func (l *ledger) getDecisions() map[uint32]*Decision {
+	l.RLock()
+	defer l.RUnlock()
+	decisions := make(map[uint32]*Decision, len(l.decisions))
+	for height, d := range l.decisions {
+		decisions[height] = d
+	}
+	return decisions
+}
+
+func (l *ledger) CanVerifyHeight(height uint32) bool {
+	return height == l.GetHeight()+1
+}
+
+func (l *ledger) TimestampCheck(header *block.Header, soft bool) error {
+	prevHeader, err := l.getHeader(header.UnsignedHeader.Height - 1)
+	if err != nil {
+		return err
+	}
+
+	t := header.UnsignedHeader.Timestamp
+	if t <= prevHeader.UnsignedHeader.Timestamp {
+		return fmt.Errorf("block timestamp %d is not later than previous block timestamp %d", t, prevHeader.UnsignedHeader.Timestamp)
+	}
+
+	now := l.sim.clock.Now()
+	earliest := now.Add(-chain.TimestampTolerancePast)
+	latest := now.Add(chain.TimestampToleranceFuture)
+	if !soft {
+		earliest = earliest.Add(-chain.TimestampToleranceVariance)
+	}
+
+	if t < earliest.Unix() || t > latest.Unix() {
+		return fmt.Errorf("block timestamp %d exceed my tolerance [%d, %d]", t, earliest.Unix(), latest.Unix())
+	}
+
+	return nil
+}
+
+func (l *ledger) HeaderCheck(header *block.Header) error {
+	currentHeight := l.GetHeight()
+	if header.UnsignedHeader.Height != currentHeight+1 {
+		return fmt.Errorf("block height %d is different from expected height %d", header.UnsignedHeader.Height, currentHeight+1)
+	}
+
+	err := l.SignerCheck(header)
+	if err != nil {
+		return fmt.Errorf("signer check failed: %v", err)
+	}
+
+	currentHash := l.GetHeaderHashByHeight(currentHeight)
+	if !bytes.Equal(header.UnsignedHeader.PrevBlockHash, currentHash.ToArray()) {
+		return fmt.Errorf("invalid prev header %x, expecting %x", header.UnsignedHeader.PrevBlockHash, currentHash.ToArray())
+	}
+
+	return nil
+}
+
+func (l *ledger) NextBlockProposerCheck(header *block.Header) error {
+	return nil
+}
+
+func (l *ledger) TransactionCheck(ctx context.Context, b *block.Block) error {
+	return nil
+}
+
+func (l *ledger) SignerCheck(header *block.Header) error {
+	publicKey, chordID, _, err := l.GetNextBlockSigner(l.GetHeight(), header.UnsignedHeader.Timestamp)
+	if err != nil {
+		return fmt.Errorf("get next block signer error: %v", err)
+	}
+
+	if !bytes.Equal(header.UnsignedHeader.SignerPk, publicKey) {
+		return fmt.Errorf("invalid block signer public key %x, should be %x", header.UnsignedHeader.SignerPk, publicKey)
+	}
+
+	if !bytes.Equal(header.UnsignedHeader.SignerId, chordID) {
+		return fmt.Errorf("invalid block signer chord ID %x, should be %x", header.UnsignedHeader.SignerId, chordID)
+	}
+
+	rawPubKey, err := crypto.DecodePoint(publicKey)
+	if err != nil {
+		return fmt.Errorf("decode public key error: %v", err)
+	}
+
+	err = crypto.Verify(*rawPubKey, signature.GetHashForSigning(header), header.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid header signature %x: %v", header.Signature, err)
+	}
+
+	return nil
+}
+
+// GetNextBlockSigner returns the proposer of block height+1 at timestamp. All
+// nodes, including offline ones, take turns: the proposer of block h in round
+// r is node (h+r) mod n.
+func (l *ledger) GetNextBlockSigner(height uint32, timestamp int64) ([]byte, []byte, pb.WinnerType, error) {
+	header, err := l.getHeader(height)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	if timestamp <= header.UnsignedHeader.Timestamp {
+		return nil, nil, 0, fmt.Errorf("timestamp %d is earlier than previous block timestamp %d", timestamp, header.UnsignedHeader.Timestamp)
+	}
+
+	round, ok := proposingRound(header.UnsignedHeader.Timestamp, timestamp)
+	if !ok {
+		return nil, nil, 0, nil
+	}
+
+	proposer := l.sim.proposer(height+1, round)
+
+	return proposer.publicKey, proposer.GetChordID(), pb.BLOCK_SIGNER, nil
+}
+
+func (l *ledger) GetNextMiningSigChainTxnHash(height uint32) (common.Uint256, pb.WinnerType, error) {
+	return common.EmptyUint256, pb.BLOCK_SIGNER, nil
+}
+
+// newBlock builds a block of the node on top of its ledger with a single txn
+// and signs it. Blocks with different variants are different blocks of the
+// same height and timestamp.
+func (n *simNode) newBlock(height uint32, chordID []byte, winnerHash common.Uint256, winnerType pb.WinnerType, timestamp int64, variant byte) (*block.Block, error) {
+	payload, err := transaction.Pack(pb.COINBASE_TYPE, transaction.NewCoinbase(common.EmptyUint160, n.account.ProgramHash, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := make([]byte, 9)
+	binary.LittleEndian.PutUint64(attrs, uint64(timestamp))
+	attrs[8] = variant
+	txn := &transaction.Transaction{
+		Transaction: transaction.NewMsgTx(payload, uint64(height), 0, attrs),
+	}
+
+	txnRoot, err := crypto.ComputeRoot([]common.Uint256{txn.Hash()})
+	if err != nil {
+		return nil, err
+	}
+
+	prevHash := n.ledger.GetHeaderHashByHeight(height - 1)
+	header := &block.Header{
+		Header: &pb.Header{
+			UnsignedHeader: &pb.UnsignedHeader{
+				Version:          config.HeaderVersion,
+				PrevBlockHash:    prevHash.ToArray(),
+				Timestamp:        timestamp,
+				Height:           height,
+				TransactionsRoot: txnRoot.ToArray(),
+				WinnerHash:       winnerHash.ToArray(),
+				WinnerType:       winnerType,
+				SignerPk:         n.publicKey,
+				SignerId:         chordID,
+			},
+		},
+	}
+
+	sig, err := crypto.Sign(n.account.PrivateKey, signature.GetHashForSigning(header))
+	if err != nil {
+		return nil, err
+	}
+	header.Signature = sig
+
+	return &block.Block{
+		Header:       header,
+		Transactions: []*transaction.Transaction{txn},
+	}, nil
+}
@@ -0,0 +1,116 @@
+package simulator
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nknorg/nkn/node"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/util/config"
+	nnetpb "github.com/nknorg/nnet/protobuf"
+)
+
+const (
+	replyTimeout = 5 * time.Second // default reply timeout of nnet
+)
+
+// neighbor is a simulated node as the neighbor of another one. Each side of a
+// link has its own neighbor, like each side of a connection has its own
+// node.RemoteNode, with the sync state, reputation and vote nonce the local
+// node knows of the remote one.
+type neighbor struct {
+	*node.Node
+	local      *simNode
+	remote     *simNode
+	reputation *node.Reputation
+
+	heightLock sync.RWMutex
+	height     uint32
+}
+
+func newNeighbor(local, remote *simNode) (*neighbor, error) {
+	n, err := node.NewNode(
+		&nnetpb.Node{Id: remote.GetChordID()},
+		&pb.NodeData{PublicKey: remote.publicKey, ProtocolVersion: config.ProtocolVersion},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &neighbor{
+		Node:       n,
+		local:      local,
+		remote:     remote,
+		reputation: node.NewReputation(local.sim.clock.Now()),
+	}, nil
+}
+
+func (nb *neighbor) GetHeight() uint32 {
+	nb.heightLock.RLock()
+	defer nb.heightLock.RUnlock()
+	return nb.height
+}
+
+func (nb *neighbor) SetHeight(height uint32) {
+	nb.heightLock.Lock()
+	defer nb.heightLock.Unlock()
+	nb.height = height
+}
+
+func (nb *neighbor) GetReputation() *node.ReputationInfo {
+	return nb.reputation.Info(nb.local.sim.clock.Now(), nb.GetSyncState() == pb.PERSIST_FINISHED)
+}
+
+func (nb *neighbor) UpdateVoteAgreement(agreed bool) {
+	nb.reputation.UpdateVoteAgreement(agreed)
+}
+
+func (nb *neighbor) UpdateProposalValidity(valid bool) {
+	nb.reputation.UpdateProposalValidity(valid)
+}
+
+func (nb *neighbor) SendBytesAsync(buf []byte) error {
+	nb.local.sim.network.send(nb.local.id, nb.remote.id, func() {
+		go nb.remote.receive(nb.local, buf)
+	})
+	return nil
+}
+
+// SendBytesSync sends a message and waits for the reply like nnet does, and
+// returns the body of the reply message.
+func (nb *neighbor) SendBytesSync(buf []byte) ([]byte, error) {
+	net := nb.local.sim.network
+	replyChan := make(chan []byte, 1)
+	net.send(nb.local.id, nb.remote.id, func() {
+		go func() {
+			reply := nb.remote.receive(nb.local, buf)
+			if len(reply) > 0 {
+				net.send(nb.remote.id, nb.local.id, func() {
+					replyChan <- reply
+				})
+			}
+		}()
+	})
+
+	timeoutTimer := nb.local.sim.clock.NewTimer(replyTimeout)
+	defer timeoutTimer.Stop()
+
+	select {
+	case reply := <-replyChan:
+		signedMsg := &pb.SignedMessage{}
+		err := proto.Unmarshal(reply, signedMsg)
+		if err != nil {
+			return nil, err
+		}
+		unsignedMsg := &pb.UnsignedMessage{}
+		err = proto.Unmarshal(signedMsg.Message, unsignedMsg)
+		if err != nil {
+			return nil, err
+		}
+		return unsignedMsg.Message, nil
+	case <-timeoutTimer.C():
+		return nil, errors.New("reply timeout")
+	}
+}
@@ -0,0 +1,117 @@
+package simulator
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Partition drops all messages between nodes in different groups from Start
+// to End. Nodes not in any group form a group of their own.
+type Partition struct {
+	Start  time.Duration
+	End    time.Duration
+	Groups [][]int
+}
+
+func (p *Partition) group(node int) int {
+	for i, group := range p.Groups {
+		for _, n := range group {
+			if n == node {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// network is an in-memory transport between simulated nodes. Latency and loss
+// are drawn from a random source of each direction of a link, so what happens
+// to the messages on a link only depends on the seed and the order they are
+// sent in.
+type network struct {
+	sync.Mutex
+	clock      *clock
+	seed       int64
+	links      map[[2]int]*rand.Rand
+	minLatency time.Duration
+	maxLatency time.Duration
+	loss       float64
+	partitions []Partition
+	offline    map[int]bool
+
+	sent    int
+	dropped int
+}
+
+func newNetwork(c *clock, cfg *Config) *network {
+	net := &network{
+		clock:      c,
+		seed:       cfg.Seed,
+		links:      make(map[[2]int]*rand.Rand),
+		minLatency: cfg.MinLatency,
+		maxLatency: cfg.MaxLatency,
+		loss:       cfg.Loss,
+		partitions: cfg.Partitions,
+		offline:    make(map[int]bool),
+	}
+	for _, i := range cfg.Offline {
+		net.offline[i] = true
+	}
+	return net
+}
+
+// link returns the random source of messages from one node to another.
+// Caller should hold the lock.
+func (net *network) link(from, to int) *rand.Rand {
+	key := [2]int{from, to}
+	rnd, ok := net.links[key]
+	if !ok {
+		rnd = rand.New(rand.NewSource(net.seed ^ int64(from)<<32 ^ int64(to)))
+		net.links[key] = rnd
+	}
+	return rnd
+}
+
+// partitioned returns if messages between two nodes are dropped by a
+// partition at time now.
+func (net *network) partitioned(from, to int, now time.Duration) bool {
+	for i := range net.partitions {
+		p := &net.partitions[i]
+		if now >= p.Start && now < p.End && p.group(from) != p.group(to) {
+			return true
+		}
+	}
+	return false
+}
+
+// send delivers a message from one node to another by running deliver after
+// a random latency, unless the message is dropped.
+func (net *network) send(from, to int, deliver func()) {
+	net.Lock()
+	defer net.Unlock()
+
+	net.sent++
+
+	// always draw both numbers so a change of loss rate or partitions does not
+	// shift the random sequence of later messages
+	rnd := net.link(from, to)
+	lost := rnd.Float64() < net.loss
+	latency := net.minLatency
+	if net.maxLatency > net.minLatency {
+		latency += time.Duration(rnd.Int63n(int64(net.maxLatency - net.minLatency)))
+	}
+
+	if lost || net.offline[from] || net.offline[to] || net.partitioned(from, to, net.clock.elapsed()) {
+		net.dropped++
+		return
+	}
+
+	net.clock.after(latency, deliver)
+}
+
+func (net *network) stats() (sent, dropped int) {
+	net.Lock()
+	defer net.Unlock()
+	return net.sent, net.dropped
+}
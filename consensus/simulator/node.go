@@ -0,0 +1,524 @@
+package simulator
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/consensus"
+	"github.com/nknorg/nkn/consensus/evidence"
+	"github.com/nknorg/nkn/consensus/trace"
+	"github.com/nknorg/nkn/crypto"
+	"github.com/nknorg/nkn/node"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/log"
+	"github.com/nknorg/nkn/vault"
+	nnetpb "github.com/nknorg/nnet/protobuf"
+)
+
+const (
+	syncRetries              = 10          // requests for blocks to sync before giving up
+	proposalPropagationDelay = time.Second // delay of consensus between proposing and sending a proposal
+)
+
+// simNode is a simulated node. It is the Transport of its consensus, and
+// builds the blocks it proposes.
+type simNode struct {
+	sim          *Simulator
+	id           int
+	account      *vault.Account
+	publicKey    []byte
+	info         *node.Node // the local node with its sync state
+	ledger       *ledger
+	consensus    *moca.Consensus
+	equivocating bool
+
+	// set when the network is created
+	neighbors      []*neighbor
+	neighborsByID  map[string]*neighbor
+	neighborsByIdx map[int]*neighbor
+
+	sync.RWMutex
+	handlers     map[pb.MessageType][]node.MessageHandler
+	syncOnce     *sync.Once
+	trace        *trace.Trace
+	evidencePool *evidence.Pool
+	conflicting  map[common.Uint256]*block.Block // conflicting proposals of an equivocating node
+}
+
+func newSimNode(sim *Simulator, id int, equivocating bool) (*simNode, error) {
+	account, err := vault.NewAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey := account.PublicKey.EncodePoint()
+	chordID := sha256.Sum256(publicKey)
+	info, err := node.NewNode(
+		&nnetpb.Node{Id: chordID[:]},
+		&pb.NodeData{PublicKey: publicKey, ProtocolVersion: config.ProtocolVersion},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	n := &simNode{
+		sim:            sim,
+		id:             id,
+		account:        account,
+		publicKey:      publicKey,
+		info:           info,
+		ledger:         newLedger(sim),
+		equivocating:   equivocating,
+		neighborsByID:  make(map[string]*neighbor),
+		neighborsByIdx: make(map[int]*neighbor),
+		handlers:       make(map[pb.MessageType][]node.MessageHandler),
+		syncOnce:       new(sync.Once),
+		conflicting:    make(map[common.Uint256]*block.Block),
+	}
+
+	if equivocating {
+		n.AddMessageHandler(pb.REQUEST_BLOCK_PROPOSAL, n.requestConflictingProposalHandler)
+		n.AddMessageHandler(pb.REQUEST_PROPOSAL_TRANSACTIONS, n.requestConflictingTransactionsHandler)
+	}
+
+	n.consensus, err = moca.NewConsensusWithConfig(account, &moca.Config{
+		Transport: n,
+		Ledger:    n.ledger,
+		Clock:     sim.clock,
+		Mining:    n,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// addNeighbor adds the other side of a link.
+func (n *simNode) addNeighbor(remote *simNode) error {
+	nb, err := newNeighbor(n, remote)
+	if err != nil {
+		return err
+	}
+	n.neighbors = append(n.neighbors, nb)
+	sort.Slice(n.neighbors, func(i, j int) bool {
+		return n.neighbors[i].remote.id < n.neighbors[j].remote.id
+	})
+	n.neighborsByID[nb.GetID()] = nb
+	n.neighborsByIdx[remote.id] = nb
+	return nil
+}
+
+// receive handles a message from a neighbor like the nnet message handler of
+// node.LocalNode, and returns the reply if any.
+func (n *simNode) receive(sender *simNode, buf []byte) []byte {
+	nb, ok := n.neighborsByIdx[sender.id]
+	if !ok {
+		log.Errorf("Node %d received message from node %d that is not a neighbor", n.id, sender.id)
+		return nil
+	}
+
+	signedMsg := &pb.SignedMessage{}
+	unsignedMsg := &pb.UnsignedMessage{}
+	err := proto.Unmarshal(buf, signedMsg)
+	if err != nil {
+		log.Errorf("Error unmarshal byte msg data: %v", err)
+		return nil
+	}
+
+	err = proto.Unmarshal(signedMsg.Message, unsignedMsg)
+	if err != nil {
+		log.Errorf("Error unmarshal signed unsigned msg: %v", err)
+		return nil
+	}
+
+	if len(signedMsg.Signature) > 0 {
+		hash := sha256.Sum256(signedMsg.Message)
+		err = crypto.Verify(*nb.GetPubKey(), hash[:], signedMsg.Signature)
+		if err != nil {
+			log.Errorf("Verify signature error: %v", err)
+			return nil
+		}
+	}
+
+	remoteMessage := &node.RemoteMessage{
+		Sender:    nb.Node,
+		Message:   unsignedMsg.Message,
+		Signed:    signedMsg.Message,
+		Signature: signedMsg.Signature,
+	}
+
+	n.RLock()
+	handlers := n.handlers[unsignedMsg.MessageType]
+	n.RUnlock()
+
+	var reply []byte
+	var shouldCallNext bool
+	for _, handler := range handlers {
+		reply, shouldCallNext, err = handler(remoteMessage)
+		if err != nil || !shouldCallNext {
+			break
+		}
+	}
+	if err != nil {
+		log.Warningf("Error handling msg: %v", err)
+		return nil
+	}
+
+	return reply
+}
+
+func (n *simNode) GetChordID() []byte {
+	return n.info.GetChordID()
+}
+
+func (n *simNode) GetSyncState() pb.SyncState {
+	return n.info.GetSyncState()
+}
+
+func (n *simNode) SetSyncState(s pb.SyncState) bool {
+	return n.info.SetSyncState(s)
+}
+
+func (n *simNode) GetMinVerifiableHeight() uint32 {
+	return n.info.GetMinVerifiableHeight()
+}
+
+func (n *simNode) SetMinVerifiableHeight(height uint32) {
+	n.info.SetMinVerifiableHeight(height)
+}
+
+func (n *simNode) GetNbrNode(id string) moca.Neighbor {
+	if nb, ok := n.neighborsByID[id]; ok {
+		return nb
+	}
+	return nil
+}
+
+func (n *simNode) GetNeighbors(filter func(moca.Neighbor) bool) []moca.Neighbor {
+	neighbors := make([]moca.Neighbor, 0, len(n.neighbors))
+	for _, nb := range n.neighbors {
+		if filter == nil || filter(nb) {
+			neighbors = append(neighbors, nb)
+		}
+	}
+	return neighbors
+}
+
+func (n *simNode) AddMessageHandler(messageType pb.MessageType, handler node.MessageHandler) {
+	n.Lock()
+	defer n.Unlock()
+	n.handlers[messageType] = append(n.handlers[messageType], handler)
+}
+
+func (n *simNode) SerializeMessage(unsignedMsg *pb.UnsignedMessage, sign bool) ([]byte, error) {
+	buf, err := proto.Marshal(unsignedMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	var signature []byte
+	if sign {
+		hash := sha256.Sum256(buf)
+		signature, err = crypto.Sign(n.account.PrivateKey, hash[:])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	signedMsg := &pb.SignedMessage{
+		Message:   buf,
+		Signature: signature,
+	}
+
+	return proto.Marshal(signedMsg)
+}
+
+// StartSyncing syncs blocks up to stopHeight from neighbors over the network,
+// trying them in turn, like node.LocalNode.StartSyncing.
+func (n *simNode) StartSyncing(stopHash common.Uint256, stopHeight uint32, neighbors []moca.Neighbor) (bool, error) {
+	var err error
+	started := false
+
+	n.RLock()
+	syncOnce := n.syncOnce
+	n.RUnlock()
+
+	syncOnce.Do(func() {
+		started = true
+		n.SetSyncState(pb.SYNC_STARTED)
+
+		currentHeight := n.ledger.GetHeight()
+		if stopHeight <= currentHeight {
+			err = fmt.Errorf("sync stop height %d is not higher than current height %d", stopHeight, currentHeight)
+			return
+		}
+
+		if len(neighbors) == 0 {
+			err = fmt.Errorf("no neighbors to sync from")
+			return
+		}
+
+		for i := 0; i < syncRetries; i++ {
+			nb, ok := neighbors[i%len(neighbors)].(*neighbor)
+			if !ok {
+				err = fmt.Errorf("neighbor %v is not a simulated neighbor", neighbors[i%len(neighbors)])
+				return
+			}
+
+			err = n.syncBlocks(nb, n.ledger.GetHeight()+1, stopHeight, stopHash)
+			if err == nil {
+				n.SetSyncState(pb.SYNC_FINISHED)
+				return
+			}
+
+			log.Warningf("Sync blocks from neighbor %v error: %v", nb.GetID(), err)
+		}
+	})
+
+	return started, err
+}
+
+// syncBlocks requests blocks from startHeight to stopHeight from a neighbor,
+// checks they end at stopHash and adds them to the ledger, which checks they
+// are chained.
+func (n *simNode) syncBlocks(nb *neighbor, startHeight, stopHeight uint32, stopHash common.Uint256) error {
+	replyChan := make(chan [][]byte, 1)
+	n.sim.network.send(n.id, nb.remote.id, func() {
+		bufs, err := nb.remote.ledger.getBlocks(startHeight, stopHeight)
+		if err != nil {
+			log.Errorf("Get blocks error: %v", err)
+			return
+		}
+		n.sim.network.send(nb.remote.id, n.id, func() {
+			replyChan <- bufs
+		})
+	})
+
+	timeoutTimer := n.sim.clock.NewTimer(replyTimeout)
+	defer timeoutTimer.Stop()
+
+	var bufs [][]byte
+	select {
+	case bufs = <-replyChan:
+	case <-timeoutTimer.C():
+		return errors.New("reply timeout")
+	}
+
+	if len(bufs) != int(stopHeight-startHeight+1) {
+		return fmt.Errorf("got %d blocks instead of %d", len(bufs), stopHeight-startHeight+1)
+	}
+
+	blocks := make([]*block.Block, len(bufs))
+	for i, buf := range bufs {
+		blocks[i] = &block.Block{}
+		err := blocks[i].Unmarshal(buf)
+		if err != nil {
+			return err
+		}
+	}
+
+	endHash := blocks[len(blocks)-1].Hash()
+	if endHash != stopHash {
+		return fmt.Errorf("end block hash %s is different from stop hash %s", endHash.ToHexString(), stopHash.ToHexString())
+	}
+
+	for _, b := range blocks {
+		err := n.ledger.addBlock(b, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResetSyncing allows for future block syncing.
+func (n *simNode) ResetSyncing() {
+	n.Lock()
+	defer n.Unlock()
+	n.syncOnce = new(sync.Once)
+}
+
+func (n *simNode) IncrementProposalSubmitted() {
+}
+
+// GetTxnByHash returns nil as simulated nodes have no txn pool.
+func (n *simNode) GetTxnByHash(hash common.Uint256) *transaction.Transaction {
+	return nil
+}
+
+// GetTxnByShortHash returns nil as simulated nodes have no txn pool.
+func (n *simNode) GetTxnByShortHash(shortHash []byte) *transaction.Transaction {
+	return nil
+}
+
+func (n *simNode) SetConsensusTrace(t *trace.Trace) {
+	n.Lock()
+	defer n.Unlock()
+	n.trace = t
+}
+
+func (n *simNode) SetEvidencePool(p *evidence.Pool) {
+	n.Lock()
+	defer n.Unlock()
+	n.evidencePool = p
+}
+
+// getEvidence returns the nodes the node has equivocation evidence against.
+func (n *simNode) getEvidence() []int {
+	n.RLock()
+	pool := n.evidencePool
+	n.RUnlock()
+
+	found := make(map[int]bool)
+	for _, e := range pool.Get("") {
+		if nb, ok := n.neighborsByID[e.NodeID]; ok {
+			found[nb.remote.id] = true
+		}
+	}
+
+	ids := make([]int, 0, len(found))
+	for id := range found {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	return ids
+}
+
+// BuildBlock builds the block the node proposes. An equivocating node also
+// builds a conflicting block, and sends it to half of its neighbors when
+// consensus sends out the proposal.
+func (n *simNode) BuildBlock(ctx context.Context, height uint32, chordID []byte, winnerHash common.Uint256, winnerType pb.WinnerType, timestamp int64) (*block.Block, error) {
+	b, err := n.newBlock(height, chordID, winnerHash, winnerType, timestamp, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.equivocating {
+		conflicting, err := n.newBlock(height, chordID, winnerHash, winnerType, timestamp, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		n.Lock()
+		n.conflicting[conflicting.Hash()] = conflicting
+		n.Unlock()
+
+		n.sim.clock.AfterFunc(proposalPropagationDelay, func() {
+			n.sendConflictingProposal(conflicting)
+		})
+	}
+
+	return b, nil
+}
+
+// sendConflictingProposal sends I_HAVE_BLOCK_PROPOSAL of a conflicting
+// proposal to every other neighbor.
+func (n *simNode) sendConflictingProposal(b *block.Block) {
+	msg, err := moca.NewIHaveBlockProposalMessage(b.Header.UnsignedHeader.Height, b.Hash())
+	if err != nil {
+		log.Errorf("Create I have block message error: %v", err)
+		return
+	}
+
+	buf, err := n.SerializeMessage(msg, false)
+	if err != nil {
+		log.Errorf("Serialize I have block message error: %v", err)
+		return
+	}
+
+	for i, nb := range n.neighbors {
+		if i%2 == 1 {
+			nb.SendBytesAsync(buf)
+		}
+	}
+}
+
+func (n *simNode) getConflictingProposal(blockHash []byte) *block.Block {
+	hash, err := common.Uint256ParseFromBytes(blockHash)
+	if err != nil {
+		return nil
+	}
+
+	n.RLock()
+	defer n.RUnlock()
+	return n.conflicting[hash]
+}
+
+// requestConflictingProposalHandler replies to REQUEST_BLOCK_PROPOSAL of a
+// conflicting proposal, which consensus does not know about, and passes other
+// requests on to consensus.
+func (n *simNode) requestConflictingProposalHandler(remoteMessage *node.RemoteMessage) ([]byte, bool, error) {
+	msgBody := &pb.RequestBlockProposal{}
+	err := proto.Unmarshal(remoteMessage.Message, msgBody)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b := n.getConflictingProposal(msgBody.BlockHash)
+	if b == nil {
+		return nil, true, nil
+	}
+
+	var replyMsg *pb.UnsignedMessage
+	switch msgBody.Type {
+	case pb.REQUEST_TRANSACTION_HASH:
+		txnsHash := make([][]byte, len(b.Transactions))
+		for i, txn := range b.Transactions {
+			txnHash := txn.Hash()
+			txnsHash[i] = txnHash.ToArray()
+		}
+		replyMsg, err = moca.NewRequestBlockProposalReply(&block.Block{Header: b.Header}, txnsHash)
+	case pb.REQUEST_TRANSACTION_SHORT_HASH:
+		txnsHash := make([][]byte, len(b.Transactions))
+		for i, txn := range b.Transactions {
+			txnsHash[i] = txn.ShortHash(msgBody.ShortHashSalt, msgBody.ShortHashSize)
+		}
+		replyMsg, err = moca.NewRequestBlockProposalReply(&block.Block{Header: b.Header}, txnsHash)
+	default:
+		replyMsg, err = moca.NewRequestBlockProposalReply(b, nil)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	replyBuf, err := n.SerializeMessage(replyMsg, false)
+	return replyBuf, false, err
+}
+
+// requestConflictingTransactionsHandler replies to
+// REQUEST_PROPOSAL_TRANSACTIONS of a conflicting proposal with all its txns,
+// as requesting nodes have no txn pool, and passes other requests on to
+// consensus.
+func (n *simNode) requestConflictingTransactionsHandler(remoteMessage *node.RemoteMessage) ([]byte, bool, error) {
+	msgBody := &pb.RequestProposalTransactions{}
+	err := proto.Unmarshal(remoteMessage.Message, msgBody)
+	if err != nil {
+		return nil, false, err
+	}
+
+	b := n.getConflictingProposal(msgBody.BlockHash)
+	if b == nil {
+		return nil, true, nil
+	}
+
+	replyMsg, err := moca.NewRequestProposalTransactionsReply(b.Transactions)
+	if err != nil {
+		return nil, false, err
+	}
+
+	replyBuf, err := n.SerializeMessage(replyMsg, false)
+	return replyBuf, false, err
+}
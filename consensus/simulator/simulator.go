@@ -0,0 +1,282 @@
+// Package simulator runs the MOCA consensus of many nodes in one process over
+// an in-memory network with controllable latency, partitions and message
+// loss, driven by a virtual clock.
+//
+// Each simulated node runs the real Consensus, created with
+// moca.NewConsensusWithConfig on an in-memory Transport in place of
+// node.LocalNode and nnet, an in-memory Ledger in place of the chain, and the
+// virtual clock. Neighbors are real node.Node with real node.Reputation, so
+// votes are weighted by getNeighborWeight as in a real node. The ledger only
+// keeps what consensus relies on: blocks have a single txn, and proposers take
+// turns by height in the time windows of chain.GetNextBlockSigner.
+//
+// The virtual clock advances to the next timer or message once all goroutines
+// are blocked, so minutes of consensus run in seconds. Latency and loss of
+// messages are drawn from the seed, but a run is not reproducible as a whole:
+// consensus draws its own randomness and the goroutines of a node interleave
+// freely, so runs are checked by agreement and liveness rather than by their
+// exact outcome.
+package simulator
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/util/config"
+)
+
+// Config is the simulation config.
+type Config struct {
+	Nodes        int
+	Neighbors    int // neighbors of each node, full mesh if 0
+	Seed         int64
+	TargetHeight uint32        // run until all online nodes have this height
+	MaxTime      time.Duration // virtual time limit of a run
+
+	MinLatency time.Duration
+	MaxLatency time.Duration
+	Loss       float64 // probability a message is dropped
+	Partitions []Partition
+
+	Offline               []int // nodes that never run
+	EquivocatingProposers []int // nodes that send conflicting proposals
+}
+
+// DefaultConfig returns the config of a fully connected network of n nodes
+// without faults.
+func DefaultConfig(n int) *Config {
+	return &Config{
+		Nodes:        n,
+		Seed:         1,
+		TargetHeight: 10,
+		MaxTime:      100 * config.ConsensusDuration,
+		MinLatency:   20 * time.Millisecond,
+		MaxLatency:   200 * time.Millisecond,
+	}
+}
+
+// Simulator is a simulated network of consensus nodes.
+type Simulator struct {
+	config  *Config
+	clock   *clock
+	network *network
+	genesis *block.Block
+	nodes   []*simNode
+}
+
+// NewSimulator creates a simulator from config. Mining is enabled in the
+// global config, as consensus only proposes blocks when it is.
+func NewSimulator(cfg *Config) (*Simulator, error) {
+	if cfg.Nodes < 2 {
+		return nil, fmt.Errorf("need at least 2 nodes, got %d", cfg.Nodes)
+	}
+	if cfg.MinLatency > cfg.MaxLatency {
+		return nil, fmt.Errorf("min latency %v is greater than max latency %v", cfg.MinLatency, cfg.MaxLatency)
+	}
+
+	config.Parameters.Mining = true
+
+	c := &clock{}
+	sim := &Simulator{
+		config:  cfg,
+		clock:   c,
+		network: newNetwork(c, cfg),
+		genesis: newGenesisBlock(),
+		nodes:   make([]*simNode, cfg.Nodes),
+	}
+
+	equivocating := make(map[int]bool)
+	for _, i := range cfg.EquivocatingProposers {
+		equivocating[i] = true
+	}
+
+	var err error
+	for i := range sim.nodes {
+		sim.nodes[i], err = newSimNode(sim, i, equivocating[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = sim.connect(rand.New(rand.NewSource(cfg.Seed)))
+	if err != nil {
+		return nil, err
+	}
+
+	return sim, nil
+}
+
+// connect connects nodes in a ring, then adds random neighbors until each node
+// has cfg.Neighbors neighbors, or connects all nodes if cfg.Neighbors is 0.
+func (sim *Simulator) connect(rnd *rand.Rand) error {
+	n := len(sim.nodes)
+	connected := make([]map[int]bool, n)
+	for i := range connected {
+		connected[i] = make(map[int]bool)
+	}
+	link := func(i, j int) {
+		if i != j {
+			connected[i][j] = true
+			connected[j][i] = true
+		}
+	}
+
+	if sim.config.Neighbors <= 0 || sim.config.Neighbors >= n-1 {
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				link(i, j)
+			}
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			link(i, (i+1)%n)
+		}
+		for i := 0; i < n; i++ {
+			for len(connected[i]) < sim.config.Neighbors {
+				link(i, rnd.Intn(n))
+			}
+		}
+	}
+
+	for i, node := range sim.nodes {
+		for j := range connected[i] {
+			err := node.addNeighbor(sim.nodes[j])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// proposer returns the node that proposes block height in round.
+func (sim *Simulator) proposer(height, round uint32) *simNode {
+	return sim.nodes[(uint64(height)+uint64(round))%uint64(len(sim.nodes))]
+}
+
+func (sim *Simulator) online() []*simNode {
+	nodes := make([]*simNode, 0, len(sim.nodes))
+	for _, node := range sim.nodes {
+		if !sim.network.offline[node.id] {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+func (sim *Simulator) done() bool {
+	for _, node := range sim.online() {
+		if node.ledger.GetHeight() < sim.config.TargetHeight {
+			return false
+		}
+	}
+	return true
+}
+
+// Run runs the simulation until all online nodes have the target height or
+// the virtual time limit is reached. A simulator can only run once. Consensus
+// can not be stopped, so its goroutines stay blocked on the clock after Run
+// returns.
+func (sim *Simulator) Run() *Result {
+	for _, node := range sim.online() {
+		node.consensus.Start()
+	}
+
+	for {
+		waitIdle()
+		if sim.done() || !sim.clock.step(sim.config.MaxTime) {
+			break
+		}
+	}
+
+	sent, dropped := sim.network.stats()
+	result := &Result{
+		Time:            sim.clock.elapsed(),
+		MessagesSent:    sent,
+		MessagesDropped: dropped,
+		Decisions:       make(map[int]map[uint32]*Decision),
+		Evidence:        make(map[int][]int),
+		targetHeight:    sim.config.TargetHeight,
+	}
+	for _, node := range sim.online() {
+		result.Decisions[node.id] = node.ledger.getDecisions()
+		result.Evidence[node.id] = node.getEvidence()
+	}
+
+	return result
+}
+
+// Result is the outcome of a simulation run.
+type Result struct {
+	Time            time.Duration // virtual time when the run stopped
+	MessagesSent    int
+	MessagesDropped int
+	Decisions       map[int]map[uint32]*Decision // by online node and height
+	Evidence        map[int][]int                // nodes each online node has equivocation evidence against
+
+	targetHeight uint32
+}
+
+// RetriedHeights returns the number of heights whose block was proposed after
+// the proposer of the first round failed to get a block accepted.
+func (r *Result) RetriedHeights() int {
+	retried := make(map[uint32]bool)
+	for _, decisions := range r.Decisions {
+		for height, d := range decisions {
+			if d.Round > 0 {
+				retried[height] = true
+			}
+		}
+	}
+	return len(retried)
+}
+
+// CheckAgreement returns error if two nodes decided different blocks at the
+// same height.
+func (r *Result) CheckAgreement() error {
+	nodes := make([]int, 0, len(r.Decisions))
+	for id := range r.Decisions {
+		nodes = append(nodes, id)
+	}
+	sort.Ints(nodes)
+
+	decided := make(map[uint32]int)
+	for _, id := range nodes {
+		for height, d := range r.Decisions[id] {
+			first, ok := decided[height]
+			if !ok {
+				decided[height] = id
+				continue
+			}
+			if r.Decisions[first][height].Hash != d.Hash {
+				return fmt.Errorf("node %d decided %s but node %d decided %s at height %d", first, r.Decisions[first][height].Hash.ToHexString(), id, d.Hash.ToHexString(), height)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckLiveness returns error if an online node has not decided every height
+// up to the target height.
+func (r *Result) CheckLiveness() error {
+	nodes := make([]int, 0, len(r.Decisions))
+	for id := range r.Decisions {
+		nodes = append(nodes, id)
+	}
+	sort.Ints(nodes)
+
+	for _, id := range nodes {
+		for height := uint32(1); height <= r.targetHeight; height++ {
+			if _, ok := r.Decisions[id][height]; !ok {
+				return fmt.Errorf("node %d has not decided height %d after %v", id, height, r.Time)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package simulator
+
+import (
+	"flag"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/log"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+		if err != nil {
+			panic(err)
+		}
+		log.Stdout = devNull
+	}
+	os.Exit(m.Run())
+}
+
+func run(t *testing.T, cfg *Config) *Result {
+	sim, err := NewSimulator(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sim.Run()
+}
+
+func checkResult(t *testing.T, result *Result) {
+	if err := result.CheckAgreement(); err != nil {
+		t.Fatal(err)
+	}
+	if err := result.CheckLiveness(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConsensus(t *testing.T) {
+	cfg := DefaultConfig(7)
+	result := run(t, cfg)
+	checkResult(t, result)
+	if n := result.RetriedHeights(); n > 0 {
+		t.Fatalf("%d heights retried without faults", n)
+	}
+
+	cfg = DefaultConfig(30)
+	cfg.Neighbors = 6
+	checkResult(t, run(t, cfg))
+}
+
+func TestNetworkDeterministic(t *testing.T) {
+	schedule := func() []time.Duration {
+		cfg := DefaultConfig(3)
+		cfg.Loss = 0.2
+		c := &clock{}
+		net := newNetwork(c, cfg)
+
+		var delivered []time.Duration
+		for i := 0; i < 100; i++ {
+			net.send(i%3, (i+1)%3, func() {
+				delivered = append(delivered, c.now)
+			})
+		}
+		for c.step(time.Hour) {
+		}
+		return delivered
+	}
+
+	s1, s2 := schedule(), schedule()
+	if len(s1) == 0 || len(s1) == 100 {
+		t.Fatalf("expect some of 100 messages dropped, got %d delivered", len(s1))
+	}
+	if !reflect.DeepEqual(s1, s2) {
+		t.Fatal("networks with the same seed delivered messages differently")
+	}
+}
+
+func TestFaults(t *testing.T) {
+	// consensus sends each vote once over links nnet keeps reliable, so heavy
+	// loss can split elections of a real network too
+	cfg := DefaultConfig(10)
+	cfg.Loss = 0.02
+	checkResult(t, run(t, cfg))
+
+	cfg = DefaultConfig(10)
+	cfg.Offline = []int{3}
+	result := run(t, cfg)
+	checkResult(t, result)
+	if result.RetriedHeights() == 0 {
+		t.Fatal("heights of the offline proposer should be retried")
+	}
+
+	cfg = DefaultConfig(10)
+	cfg.EquivocatingProposers = []int{2, 5}
+	result = run(t, cfg)
+	checkResult(t, result)
+	found := make(map[int]bool)
+	for id, nodes := range result.Evidence {
+		for _, n := range nodes {
+			if n != 2 && n != 5 {
+				t.Fatalf("node %d has evidence against honest node %d", id, n)
+			}
+			found[n] = true
+		}
+	}
+	if !found[2] || !found[5] {
+		t.Fatalf("expect evidence against nodes 2 and 5, got %v", result.Evidence)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	// minority partition catches up by syncing after it heals
+	cfg := DefaultConfig(10)
+	cfg.Partitions = []Partition{{Start: 120 * time.Second, End: 240 * time.Second, Groups: [][]int{{0, 1, 2}}}}
+	result := run(t, cfg)
+	checkResult(t, result)
+	synced := false
+	for _, id := range []int{0, 1, 2} {
+		for _, d := range result.Decisions[id] {
+			synced = synced || d.Synced
+		}
+	}
+	if !synced {
+		t.Fatal("minority partition should sync blocks after it heals")
+	}
+
+	// no side of an even split can reach consensus until it heals, except for
+	// the election that started before it
+	cfg = DefaultConfig(10)
+	split := Partition{Start: 120 * time.Second, End: 240 * time.Second, Groups: [][]int{{0, 1, 2, 3, 4}}}
+	cfg.Partitions = []Partition{split}
+	result = run(t, cfg)
+	checkResult(t, result)
+	for id, decisions := range result.Decisions {
+		for height, d := range decisions {
+			if d.Time > split.Start+config.ConsensusDuration && d.Time < split.End {
+				t.Fatalf("node %d decided height %d at %v during an even split", id, height, d.Time)
+			}
+		}
+	}
+	if result.RetriedHeights() == 0 {
+		t.Fatal("height during an even split should be retried")
+	}
+}
+
+func TestCheckAgreement(t *testing.T) {
+	result := &Result{
+		Decisions: map[int]map[uint32]*Decision{
+			0: {1: {Hash: common.Uint256{1}}, 2: {Hash: common.Uint256{2}}},
+			1: {1: {Hash: common.Uint256{1}}},
+		},
+		targetHeight: 2,
+	}
+	if err := result.CheckAgreement(); err != nil {
+		t.Fatal(err)
+	}
+	if err := result.CheckLiveness(); err == nil {
+		t.Fatal("expect node 1 missing height 2")
+	}
+
+	result.Decisions[1][2] = &Decision{Hash: common.Uint256{3}}
+	if err := result.CheckAgreement(); err == nil {
+		t.Fatal("expect disagreement at height 2")
+	}
+}
@@ -2,11 +2,8 @@ package moca
 
 import (
 	"sync"
-	"time"
 
 	"github.com/gogo/protobuf/proto"
-	"github.com/nknorg/nkn/chain"
-	"github.com/nknorg/nkn/node"
 	"github.com/nknorg/nkn/pb"
 	"github.com/nknorg/nkn/por"
 	"github.com/nknorg/nkn/util"
@@ -17,16 +14,16 @@ import (
 // startGettingNeighborConsensusState peroidically checks neighbors' majority
 // consensus height and sets local height if fall behind
 func (consensus *Consensus) startGettingNeighborConsensusState() {
-	consensus.localNode.SetMinVerifiableHeight(chain.DefaultLedger.Store.GetHeight() + por.SigChainMiningHeightOffset)
+	consensus.localNode.SetMinVerifiableHeight(consensus.ledger.GetHeight() + por.SigChainMiningHeightOffset)
 
 	initialized := false
-	getNeighborConsensusStateTimer := time.NewTimer(proposingStartDelay / 2)
+	getNeighborConsensusStateTimer := consensus.clock.NewTimer(proposingStartDelay / 2)
 	for {
 		select {
-		case <-getNeighborConsensusStateTimer.C:
+		case <-getNeighborConsensusStateTimer.C():
 			majorityConsensusHeight := consensus.getNeighborsMajorityConsensusHeight()
 			localConsensusHeight := consensus.GetExpectedHeight()
-			localLedgerHeight := chain.DefaultLedger.Store.GetHeight()
+			localLedgerHeight := consensus.ledger.GetHeight()
 
 			if !initialized {
 				if majorityConsensusHeight == 0 {
@@ -56,7 +53,7 @@ func (consensus *Consensus) startGettingNeighborConsensusState() {
 
 // getNeighborConsensusState returns the latest block info (height, hash, etc)
 // of a neighbor using GET_CONSENSUS_STATE message
-func (consensus *Consensus) getNeighborConsensusState(neighbor *node.RemoteNode) (*pb.GetConsensusStateReply, error) {
+func (consensus *Consensus) getNeighborConsensusState(neighbor Neighbor) (*pb.GetConsensusStateReply, error) {
 	msg, err := NewGetConsensusStateMessage()
 	if err != nil {
 		return nil, err
@@ -92,7 +89,7 @@ func (consensus *Consensus) getAllNeighborsConsensusState() (*sync.Map, error) {
 	var wg sync.WaitGroup
 	for _, neighbor := range consensus.localNode.GetNeighbors(nil) {
 		wg.Add(1)
-		go func(neighbor *node.RemoteNode) {
+		go func(neighbor Neighbor) {
 			defer wg.Done()
 			consensusState, err := consensus.getNeighborConsensusState(neighbor)
 			if err != nil {
@@ -111,7 +108,7 @@ func (consensus *Consensus) getAllNeighborsConsensusState() (*sync.Map, error) {
 func (consensus *Consensus) getNeighborsMajorityConsensusHeight() uint32 {
 	for i := 0; i < getConsensusStateRetries; i++ {
 		if i > 0 {
-			time.Sleep(getConsensusStateRetryDelay)
+			consensus.clock.Sleep(getConsensusStateRetryDelay)
 		}
 
 		allInfo, err := consensus.getAllNeighborsConsensusState()
@@ -0,0 +1,400 @@
+package trace
+
+import (
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/nknorg/nkn/common"
+)
+
+// SelfVoter is the voter of the local node in VoteChange.
+const SelfVoter = "self"
+
+// Proposal is a block proposal received at a height.
+type Proposal struct {
+	BlockHash string `json:"blockHash"`
+	Proposer  string `json:"proposer"`
+	TxnCount  int    `json:"txnCount"`
+	Time      int64  `json:"time"`
+	Verified  bool   `json:"verified"`
+	Accepted  bool   `json:"accepted"`
+	Reason    string `json:"reason,omitempty"` // why the proposal was rejected
+}
+
+// NeighborVote is the latest vote of a neighbor and how it got there.
+type NeighborVote struct {
+	Vote      string `json:"vote"`
+	Count     int    `json:"count"`   // votes received
+	Changes   int    `json:"changes"` // votes different from the previous one
+	FirstTime int64  `json:"firstTime"`
+	LastTime  int64  `json:"lastTime"`
+}
+
+// VoteChange is a change of the vote of a neighbor or the local node.
+type VoteChange struct {
+	Time  int64  `json:"time"`
+	Voter string `json:"voter"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to"`
+}
+
+// LeadingVote is a sample of the leading vote of the election and its weight.
+type LeadingVote struct {
+	Time           int64   `json:"time"`
+	Vote           string  `json:"vote"`
+	Weight         uint32  `json:"weight"`
+	RelativeWeight float32 `json:"relativeWeight"`
+}
+
+// Result is the final result of the election at a height.
+type Result struct {
+	BlockHash     string `json:"blockHash,omitempty"`
+	Accepted      bool   `json:"accepted"`
+	Error         string `json:"error,omitempty"`
+	Votes         int    `json:"votes"`         // neighbor votes for the result
+	NeighborVotes int    `json:"neighborVotes"` // all neighbor votes
+	Time          int64  `json:"time"`
+	LatencyMs     int64  `json:"latencyMs"` // since the height was first seen
+}
+
+// Height is the consensus record of a height. Times are unix nano.
+type Height struct {
+	Height        uint32                   `json:"height"`
+	StartTime     int64                    `json:"startTime"`
+	Proposals     []*Proposal              `json:"proposals"`
+	SelfVote      string                   `json:"selfVote,omitempty"`
+	Votes         map[string]*NeighborVote `json:"votes"`
+	VoteChanges   []*VoteChange            `json:"voteChanges"`
+	LeadingVotes  []*LeadingVote           `json:"leadingVotes"`
+	Result        *Result                  `json:"result,omitempty"`
+	DroppedEvents int                      `json:"droppedEvents,omitempty"`
+}
+
+// Summary is the short form of Height used to list recorded heights.
+type Summary struct {
+	Height    uint32 `json:"height"`
+	Proposals int    `json:"proposals"`
+	Votes     int    `json:"votes"`
+	Result    string `json:"result,omitempty"`
+	Accepted  bool   `json:"accepted"`
+	LatencyMs int64  `json:"latencyMs"`
+}
+
+// Trace keeps the consensus records of the latest heights in a ring buffer.
+// Only heights from size heights before the expected height up to maxAhead
+// heights after it are recorded, so heights sent by neighbors can not take
+// over the ring. Vote changes and leading vote samples of a height are capped
+// at maxEvents, later ones are only counted in DroppedEvents.
+type Trace struct {
+	sync.Mutex
+	heights        []*Height
+	maxAhead       uint32
+	maxEvents      int
+	expectedHeight uint32
+}
+
+// NewTrace creates a trace that keeps size heights.
+func NewTrace(size int, maxAhead uint32, maxEvents int) *Trace {
+	return &Trace{
+		heights:   make([]*Height, size),
+		maxAhead:  maxAhead,
+		maxEvents: maxEvents,
+	}
+}
+
+// SetExpectedHeight sets the consensus height the local node expects.
+func (t *Trace) SetExpectedHeight(height uint32) {
+	t.Lock()
+	defer t.Unlock()
+	t.expectedHeight = height
+}
+
+// inWindow returns if height is close enough to the expected height to be
+// recorded.
+func (t *Trace) inWindow(height uint32) bool {
+	if height > t.expectedHeight {
+		return height-t.expectedHeight <= t.maxAhead
+	}
+	return t.expectedHeight-height < uint32(len(t.heights))
+}
+
+func hashString(hash common.Uint256) string {
+	return hash.ToHexString()
+}
+
+// get returns the record of height, replacing the record of an older height or
+// a height out of the window in its slot. Returns nil if height is out of the
+// window or the slot is taken by a newer height.
+func (t *Trace) get(height uint32, now time.Time) *Height {
+	if len(t.heights) == 0 || !t.inWindow(height) {
+		return nil
+	}
+
+	i := int(height % uint32(len(t.heights)))
+	h := t.heights[i]
+	if h != nil && h.Height == height {
+		return h
+	}
+	if h != nil && h.Height > height && t.inWindow(h.Height) {
+		return nil
+	}
+
+	h = &Height{
+		Height:       height,
+		StartTime:    now.UnixNano(),
+		Proposals:    make([]*Proposal, 0),
+		Votes:        make(map[string]*NeighborVote),
+		VoteChanges:  make([]*VoteChange, 0),
+		LeadingVotes: make([]*LeadingVote, 0),
+	}
+	t.heights[i] = h
+
+	return h
+}
+
+func (t *Trace) addVoteChange(h *Height, change *VoteChange) {
+	if len(h.VoteChanges) >= t.maxEvents {
+		h.DroppedEvents++
+		return
+	}
+	h.VoteChanges = append(h.VoteChanges, change)
+}
+
+// ReceiveProposal records a block proposal received at height.
+func (t *Trace) ReceiveProposal(height uint32, blockHash common.Uint256, proposer []byte, txnCount int) {
+	t.Lock()
+	defer t.Unlock()
+
+	now := time.Now()
+	h := t.get(height, now)
+	if h == nil {
+		return
+	}
+
+	hash := hashString(blockHash)
+	for _, p := range h.Proposals {
+		if p.BlockHash == hash {
+			return
+		}
+	}
+
+	if len(h.Proposals) >= t.maxEvents {
+		h.DroppedEvents++
+		return
+	}
+
+	h.Proposals = append(h.Proposals, &Proposal{
+		BlockHash: hash,
+		Proposer:  hex.EncodeToString(proposer),
+		TxnCount:  txnCount,
+		Time:      now.UnixNano(),
+	})
+}
+
+// VerifyProposal records the verification result of a proposal, err is the
+// reason it is rejected.
+func (t *Trace) VerifyProposal(height uint32, blockHash common.Uint256, err error) {
+	t.Lock()
+	defer t.Unlock()
+
+	h := t.get(height, time.Now())
+	if h == nil {
+		return
+	}
+
+	hash := hashString(blockHash)
+	for _, p := range h.Proposals {
+		if p.BlockHash == hash {
+			p.Verified = true
+			p.Accepted = err == nil
+			if err != nil {
+				p.Reason = err.Error()
+			}
+			return
+		}
+	}
+}
+
+// ReceiveVote records a vote of a neighbor at height.
+func (t *Trace) ReceiveVote(height uint32, neighborID string, vote common.Uint256) {
+	t.Lock()
+	defer t.Unlock()
+
+	now := time.Now()
+	h := t.get(height, now)
+	if h == nil {
+		return
+	}
+
+	to := hashString(vote)
+	nv, ok := h.Votes[neighborID]
+	if !ok {
+		nv = &NeighborVote{FirstTime: now.UnixNano()}
+		h.Votes[neighborID] = nv
+	}
+	if ok && nv.Vote != to {
+		nv.Changes++
+		t.addVoteChange(h, &VoteChange{Time: now.UnixNano(), Voter: neighborID, From: nv.Vote, To: to})
+	}
+	nv.Vote = to
+	nv.Count++
+	nv.LastTime = now.UnixNano()
+}
+
+// SelfVote records a vote sent by the local node at height.
+func (t *Trace) SelfVote(height uint32, vote common.Uint256) {
+	t.Lock()
+	defer t.Unlock()
+
+	now := time.Now()
+	h := t.get(height, now)
+	if h == nil {
+		return
+	}
+
+	to := hashString(vote)
+	if h.SelfVote == to {
+		return
+	}
+	t.addVoteChange(h, &VoteChange{Time: now.UnixNano(), Voter: SelfVoter, From: h.SelfVote, To: to})
+	h.SelfVote = to
+}
+
+// LeadingVote records a sample of the leading vote at height if it differs
+// from the previous sample.
+func (t *Trace) LeadingVote(height uint32, vote common.Uint256, weight uint32, relativeWeight float32) {
+	t.Lock()
+	defer t.Unlock()
+
+	now := time.Now()
+	h := t.get(height, now)
+	if h == nil {
+		return
+	}
+
+	hash := hashString(vote)
+	if n := len(h.LeadingVotes); n > 0 {
+		last := h.LeadingVotes[n-1]
+		if last.Vote == hash && last.Weight == weight && last.RelativeWeight == relativeWeight {
+			return
+		}
+	}
+
+	if len(h.LeadingVotes) >= t.maxEvents {
+		h.DroppedEvents++
+		return
+	}
+
+	h.LeadingVotes = append(h.LeadingVotes, &LeadingVote{
+		Time:           now.UnixNano(),
+		Vote:           hash,
+		Weight:         weight,
+		RelativeWeight: relativeWeight,
+	})
+}
+
+// SetResult records the election result at height. err is the election error
+// if it did not reach consensus.
+func (t *Trace) SetResult(height uint32, blockHash common.Uint256, votes, neighborVotes int, err error) {
+	t.Lock()
+	defer t.Unlock()
+
+	now := time.Now()
+	h := t.get(height, now)
+	if h == nil {
+		return
+	}
+
+	r := &Result{
+		Votes:         votes,
+		NeighborVotes: neighborVotes,
+		Time:          now.UnixNano(),
+		LatencyMs:     int64(now.Sub(time.Unix(0, h.StartTime)) / time.Millisecond),
+	}
+	if err != nil {
+		r.Error = err.Error()
+	} else {
+		r.BlockHash = hashString(blockHash)
+		r.Accepted = blockHash != common.EmptyUint256
+	}
+	h.Result = r
+}
+
+// Get returns a copy of the record of height.
+func (t *Trace) Get(height uint32) (*Height, bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	if len(t.heights) == 0 {
+		return nil, false
+	}
+
+	h := t.heights[height%uint32(len(t.heights))]
+	if h == nil || h.Height != height {
+		return nil, false
+	}
+
+	return h.copy(), true
+}
+
+// Summaries returns the summaries of all recorded heights in ascending order
+// of height.
+func (t *Trace) Summaries() []*Summary {
+	t.Lock()
+	defer t.Unlock()
+
+	summaries := make([]*Summary, 0, len(t.heights))
+	for _, h := range t.heights {
+		if h == nil {
+			continue
+		}
+		s := &Summary{
+			Height:    h.Height,
+			Proposals: len(h.Proposals),
+			Votes:     len(h.Votes),
+		}
+		if h.Result != nil {
+			s.Result = h.Result.BlockHash
+			s.Accepted = h.Result.Accepted
+			s.LatencyMs = h.Result.LatencyMs
+		}
+		summaries = append(summaries, s)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Height < summaries[j].Height
+	})
+
+	return summaries
+}
+
+func (h *Height) copy() *Height {
+	c := *h
+
+	c.Proposals = make([]*Proposal, len(h.Proposals))
+	for i, p := range h.Proposals {
+		pc := *p
+		c.Proposals[i] = &pc
+	}
+
+	c.Votes = make(map[string]*NeighborVote, len(h.Votes))
+	for k, v := range h.Votes {
+		vc := *v
+		c.Votes[k] = &vc
+	}
+
+	// entries are never modified once appended
+	c.VoteChanges = make([]*VoteChange, len(h.VoteChanges))
+	copy(c.VoteChanges, h.VoteChanges)
+	c.LeadingVotes = make([]*LeadingVote, len(h.LeadingVotes))
+	copy(c.LeadingVotes, h.LeadingVotes)
+
+	if h.Result != nil {
+		r := *h.Result
+		c.Result = &r
+	}
+
+	return &c
+}
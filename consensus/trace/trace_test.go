@@ -0,0 +1,93 @@
+package trace
+
+import (
+	"testing"
+
+	"github.com/nknorg/nkn/common"
+)
+
+func TestTraceRing(t *testing.T) {
+	tr := NewTrace(4, 2, 10)
+	tr.SetExpectedHeight(10)
+
+	vote := common.Uint256{1}
+	for height := uint32(5); height <= 14; height++ {
+		tr.ReceiveVote(height, "a", vote)
+	}
+
+	// heights 7 to 10 are in the ring, 11 and 12 are ahead of the expected
+	// height and replace 7 and 8, 13 and 14 are too far ahead
+	for height, recorded := range map[uint32]bool{5: false, 6: false, 7: false, 8: false, 9: true, 10: true, 11: true, 12: true, 13: false, 14: false} {
+		if _, ok := tr.Get(height); ok != recorded {
+			t.Fatalf("height %d recorded: got %v, want %v", height, ok, recorded)
+		}
+	}
+
+	// a newer height in the slot is kept
+	tr.SetExpectedHeight(12)
+	tr.ReceiveVote(9, "a", vote)
+	if h, ok := tr.Get(9); !ok || h.Votes["a"].Count != 2 {
+		t.Fatal("height 9 should still be recorded")
+	}
+	tr.SetExpectedHeight(13)
+	tr.ReceiveVote(9, "a", vote)
+	if h, _ := tr.Get(9); h.Votes["a"].Count != 2 {
+		t.Fatal("height out of window should not be recorded")
+	}
+	tr.ReceiveVote(13, "a", vote)
+	if _, ok := tr.Get(9); ok {
+		t.Fatal("height 13 should replace height 9")
+	}
+	if _, ok := tr.Get(13); !ok {
+		t.Fatal("height 13 should be recorded")
+	}
+
+	// heights far ahead do not block recording
+	tr.ReceiveVote(0xFFFFFFFF, "a", vote)
+	tr.ReceiveVote(0xFFFFFFFE, "a", vote)
+	if _, ok := tr.Get(0xFFFFFFFF); ok {
+		t.Fatal("height far ahead should not be recorded")
+	}
+	tr.SetExpectedHeight(14)
+	tr.ReceiveVote(14, "a", vote)
+	if _, ok := tr.Get(14); !ok {
+		t.Fatal("height 14 should be recorded")
+	}
+
+	summaries := tr.Summaries()
+	if len(summaries) != 4 {
+		t.Fatalf("got %d summaries, want 4", len(summaries))
+	}
+	for i, s := range summaries {
+		if s.Height != uint32(11+i) {
+			t.Fatalf("summary %d has height %d, want %d", i, s.Height, 11+i)
+		}
+	}
+}
+
+func TestTraceEvents(t *testing.T) {
+	tr := NewTrace(4, 2, 2)
+	tr.SetExpectedHeight(1)
+
+	for i := byte(1); i <= 4; i++ {
+		tr.ReceiveVote(1, "a", common.Uint256{i})
+	}
+	tr.SelfVote(1, common.Uint256{1})
+
+	h, ok := tr.Get(1)
+	if !ok {
+		t.Fatal("height 1 should be recorded")
+	}
+	if h.Votes["a"].Changes != 3 || h.Votes["a"].Count != 4 {
+		t.Fatalf("got %d changes in %d votes, want 3 in 4", h.Votes["a"].Changes, h.Votes["a"].Count)
+	}
+	if len(h.VoteChanges) != 2 || h.DroppedEvents != 2 {
+		t.Fatalf("got %d vote changes and %d dropped, want 2 and 2", len(h.VoteChanges), h.DroppedEvents)
+	}
+
+	// a returned record is a copy
+	h.Votes["a"].Count = 0
+	if h, _ := tr.Get(1); h.Votes["a"].Count != 4 {
+		t.Fatal("modifying a returned record changed the trace")
+	}
+}
@@ -0,0 +1,113 @@
+package moca
+
+import (
+	"fmt"
+
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/consensus/evidence"
+	"github.com/nknorg/nkn/consensus/trace"
+	"github.com/nknorg/nkn/crypto"
+	"github.com/nknorg/nkn/node"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/por"
+	"github.com/nknorg/nkn/transaction"
+)
+
+// Neighbor is a neighbor of the local node as seen by consensus. It is
+// implemented by node.RemoteNode.
+type Neighbor interface {
+	GetID() string
+	GetPubKey() *crypto.PubKey
+	GetProtocolVersion() uint32
+	GetHeight() uint32
+	SetHeight(height uint32)
+	GetSyncState() pb.SyncState
+	SetSyncState(s pb.SyncState) bool
+	GetMinVerifiableHeight() uint32
+	SetMinVerifiableHeight(height uint32)
+	GetReputation() *node.ReputationInfo
+	UpdateVoteAgreement(agreed bool)
+	UpdateProposalValidity(valid bool)
+	SendBytesAsync(buf []byte) error
+	SendBytesSync(buf []byte) ([]byte, error)
+}
+
+// Transport is the local node and its network as seen by consensus. It is
+// implemented over nnet by node.LocalNode, and in memory by the simulator.
+type Transport interface {
+	GetChordID() []byte
+	GetSyncState() pb.SyncState
+	SetSyncState(s pb.SyncState) bool
+	GetMinVerifiableHeight() uint32
+	SetMinVerifiableHeight(height uint32)
+	GetNbrNode(id string) Neighbor
+	GetNeighbors(filter func(Neighbor) bool) []Neighbor
+	AddMessageHandler(messageType pb.MessageType, handler node.MessageHandler)
+	SerializeMessage(unsignedMsg *pb.UnsignedMessage, sign bool) ([]byte, error)
+	StartSyncing(stopHash common.Uint256, stopHeight uint32, neighbors []Neighbor) (bool, error)
+	ResetSyncing()
+	IncrementProposalSubmitted()
+	GetTxnByHash(hash common.Uint256) *transaction.Transaction
+	GetTxnByShortHash(shortHash []byte) *transaction.Transaction
+	SetConsensusTrace(t *trace.Trace)
+	SetEvidencePool(p *evidence.Pool)
+}
+
+// localNodeTransport is the Transport of node.LocalNode
+type localNodeTransport struct {
+	*node.LocalNode
+}
+
+func (t *localNodeTransport) GetNbrNode(id string) Neighbor {
+	if neighbor := t.LocalNode.GetNbrNode(id); neighbor != nil {
+		return neighbor
+	}
+	return nil
+}
+
+func (t *localNodeTransport) GetNeighbors(filter func(Neighbor) bool) []Neighbor {
+	remoteNodes := t.LocalNode.GetNeighbors(func(rn *node.RemoteNode) bool {
+		return filter == nil || filter(rn)
+	})
+	neighbors := make([]Neighbor, 0, len(remoteNodes))
+	for _, rn := range remoteNodes {
+		neighbors = append(neighbors, rn)
+	}
+	return neighbors
+}
+
+func (t *localNodeTransport) StartSyncing(stopHash common.Uint256, stopHeight uint32, neighbors []Neighbor) (bool, error) {
+	remoteNodes := make([]*node.RemoteNode, 0, len(neighbors))
+	for _, neighbor := range neighbors {
+		rn, ok := neighbor.(*node.RemoteNode)
+		if !ok {
+			return false, fmt.Errorf("neighbor %v is not a remote node", neighbor)
+		}
+		remoteNodes = append(remoteNodes, rn)
+	}
+	return t.LocalNode.StartSyncing(stopHash, stopHeight, remoteNodes)
+}
+
+// GetTxnByHash returns a txn from txn pool or sigchain txns of PoR server, or
+// nil if not found.
+func (t *localNodeTransport) GetTxnByHash(hash common.Uint256) *transaction.Transaction {
+	if txn := t.TxnPool.GetTxnByHash(hash); txn != nil {
+		return txn
+	}
+	if txn, err := por.GetPorServer().GetSigChainTxn(hash); err == nil && txn != nil {
+		return txn
+	}
+	return nil
+}
+
+// GetTxnByShortHash returns a txn from txn pool or sigchain txns of PoR
+// server by short hash, or nil if not found.
+func (t *localNodeTransport) GetTxnByShortHash(shortHash []byte) *transaction.Transaction {
+	if txn := t.TxnPool.GetTxnByShortHash(shortHash); txn != nil {
+		return txn
+	}
+	if txn, err := por.GetPorServer().GetSigChainTxnByShortHash(shortHash); err == nil && txn != nil {
+		return txn
+	}
+	return nil
+}
@@ -29,23 +29,35 @@ func (consensus *Consensus) receiveVote(neighborID string, height uint32, blockH
 		return fmt.Errorf("reveive vote at %d for %s error: %v", height, blockHash.ToHexString(), err)
 	}
 
+	consensus.trace.ReceiveVote(height, neighborID, blockHash)
+
 	return nil
 }
 
-// vote sends out a VOTE message to all neighbors voting for a block proposal at
-// certain height
+// vote sends out a VOTE message to all neighbors voting for a block proposal
+// at certain height. The vote is signed for neighbors with protocol version
+// that accepts signed votes, and unsigned for older ones.
 func (consensus *Consensus) vote(height uint32, blockHash common.Uint256) error {
-	msg, err := NewVoteMessage(height, blockHash)
+	msg, err := NewVoteMessage(height, consensus.nextVoteSeq(height), consensus.voteNonce, blockHash)
+	if err != nil {
+		return err
+	}
+
+	signedBuf, err := consensus.localNode.SerializeMessage(msg, true)
 	if err != nil {
 		return err
 	}
 
-	buf, err := consensus.localNode.SerializeMessage(msg, false)
+	unsignedBuf, err := consensus.localNode.SerializeMessage(msg, false)
 	if err != nil {
 		return err
 	}
 
 	for _, neighbor := range consensus.localNode.GetNeighbors(nil) {
+		buf := unsignedBuf
+		if neighbor.GetProtocolVersion() >= signedVoteProtocolVersion {
+			buf = signedBuf
+		}
 		err = neighbor.SendBytesAsync(buf)
 		if err != nil {
 			log.Errorf("Send vote to neighbor %v error: %v", neighbor, err)
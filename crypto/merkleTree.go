@@ -21,8 +21,9 @@ var (
 )
 
 type MerkleTree struct {
-	Depth uint
-	Root  *MerkleTreeNode
+	Depth  uint
+	Root   *MerkleTreeNode
+	leaves int
 }
 
 type MerkleTreeNode struct {
@@ -49,8 +50,9 @@ func NewMerkleTree(hashes []Uint256) (*MerkleTree, error) {
 		height += 1
 	}
 	mt := &MerkleTree{
-		Root:  nodes[0],
-		Depth: height,
+		Root:   nodes[0],
+		Depth:  height,
+		leaves: len(hashes),
 	}
 	return mt, nil
 
@@ -109,3 +111,55 @@ func ComputeRoot(hashes []Uint256) (Uint256, error) {
 	tree, _ := NewMerkleTree(hashes)
 	return tree.Root.Hash, nil
 }
+
+//get the sibling hashes on the path from the leaf at index to the root,
+//ordered from the leaf level up
+func (t *MerkleTree) GetBranch(index int) ([]Uint256, error) {
+	if index < 0 || index >= t.leaves {
+		return nil, errors.New("MerkleTree leaf index out of range.")
+	}
+
+	branch := make([]Uint256, t.Depth-1)
+	node := t.Root
+	for level := int(t.Depth) - 2; level >= 0; level-- {
+		if (index>>uint(level))&1 == 0 {
+			branch[level] = node.Right.Hash
+			node = node.Left
+		} else {
+			branch[level] = node.Left.Hash
+			node = node.Right
+		}
+	}
+
+	return branch, nil
+}
+
+//check that the leaf at index of a tree with count leaves together with branch
+//hashes up to root. count bounds the index, as the last node of a level with
+//odd number of nodes is paired with itself and would verify at the index
+//next to it as well
+func VerifyBranch(leaf Uint256, index, count int, branch []Uint256, root Uint256) bool {
+	if index < 0 || index >= count {
+		return false
+	}
+
+	depth := 0
+	for n := count; n > 1; n = (n + 1) / 2 {
+		depth++
+	}
+	if len(branch) != depth {
+		return false
+	}
+
+	hash := leaf
+	for _, sibling := range branch {
+		if index%2 == 0 {
+			hash = DOUBLE_SHA256([]Uint256{hash, sibling})
+		} else {
+			hash = DOUBLE_SHA256([]Uint256{sibling, hash})
+		}
+		index /= 2
+	}
+
+	return hash == root
+}
@@ -25,3 +25,38 @@ func TestHash(t *testing.T) {
 	fmt.Printf("[Root Hash]:%x\n", x)
 
 }
+
+func TestBranch(t *testing.T) {
+	for n := 1; n <= 9; n++ {
+		var data []Uint256
+		for i := 0; i < n; i++ {
+			data = append(data, Uint256(sha256.Sum256([]byte{byte(i)})))
+		}
+		root, _ := ComputeRoot(data)
+		tree, _ := NewMerkleTree(data)
+
+		for i, leaf := range data {
+			branch, err := tree.GetBranch(i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !VerifyBranch(leaf, i, n, branch, root) {
+				t.Fatalf("branch of leaf %d/%d does not verify", i, n)
+			}
+			if VerifyBranch(leaf, i+1<<uint(len(branch)), n, branch, root) {
+				t.Fatalf("branch of leaf %d/%d verifies with out of range index", i, n)
+			}
+			if VerifyBranch(leaf, i, n+1<<uint(len(branch)), branch, root) {
+				t.Fatalf("branch of leaf %d/%d verifies with wrong count", i, n)
+			}
+			// the last leaf of an odd level is paired with itself
+			if i == n-1 && n%2 == 1 && n > 1 && VerifyBranch(leaf, i+1, n, branch, root) {
+				t.Fatalf("branch of leaf %d/%d verifies at the duplicated index", i, n)
+			}
+		}
+
+		if _, err := tree.GetBranch(n); err == nil {
+			t.Fatalf("branch of leaf %d/%d should not exist", n, n)
+		}
+	}
+}
@@ -9,4 +9,7 @@ const (
 	NewBlockProduced
 	SendInboundMessageToClient
 	BacktrackSigChain
+	// TxnPoolChanged is called when a txn enters, is replaced in or leaves the
+	// txn pool other than by being included in a block
+	TxnPoolChanged
 )
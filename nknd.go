@@ -20,6 +20,7 @@ import (
 	"github.com/nknorg/nkn/api/websocket"
 	"github.com/nknorg/nkn/chain"
 	"github.com/nknorg/nkn/chain/db"
+	"github.com/nknorg/nkn/cli/snapshot"
 	. "github.com/nknorg/nkn/common"
 	"github.com/nknorg/nkn/consensus"
 	"github.com/nknorg/nkn/crypto"
@@ -383,6 +384,9 @@ func main() {
 		},
 	}
 	app.Action = nknMain
+	app.Commands = []cli.Command{
+		*snapshot.NewCommand(),
+	}
 
 	// app.Run will shutdown graceful.
 	if err := app.Run(os.Args); err != nil {
@@ -6,8 +6,10 @@ import (
 
 // RemoteMessage is the message received from remote nodes
 type RemoteMessage struct {
-	Sender  *Node
-	Message []byte
+	Sender    *Node
+	Message   []byte
+	Signed    []byte // serialized UnsignedMessage the signature is over
+	Signature []byte // empty if the message is not signed
 }
 
 // MessageHandler handles a message and returns reply, if it should be passed
@@ -14,6 +14,8 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/nknorg/nkn/chain"
 	"github.com/nknorg/nkn/chain/pool"
+	"github.com/nknorg/nkn/consensus/evidence"
+	"github.com/nknorg/nkn/consensus/trace"
 	"github.com/nknorg/nkn/crypto"
 	"github.com/nknorg/nkn/event"
 	"github.com/nknorg/nkn/pb"
@@ -46,6 +48,8 @@ type LocalNode struct {
 	relayMessageCount uint64    // count how many messages node has relayed since start
 	startTime         time.Time // Time of localNode init
 	proposalSubmitted uint32    // Count of localNode submitted proposal
+	consensusTrace    *trace.Trace
+	evidencePool      *evidence.Pool
 }
 
 func (localNode *LocalNode) MarshalJSON() ([]byte, error) {
@@ -175,6 +179,12 @@ func NewLocalNode(wallet vault.Wallet, nn *nnet.NNet) (*LocalNode, error) {
 }
 
 func (localNode *LocalNode) Start() error {
+	if config.Parameters.TxPoolJournal != "" {
+		if err := localNode.TxnPool.LoadJournal(config.Parameters.TxPoolJournal); err != nil {
+			log.Errorf("Load txpool journal error: %v", err)
+		}
+	}
+
 	localNode.startRelayer()
 	localNode.initSyncing()
 	localNode.initTxnHandlers()
@@ -288,6 +298,36 @@ func (localNode *LocalNode) IncrementRelayMessageCount() {
 	localNode.Unlock()
 }
 
+// SetConsensusTrace sets the trace the consensus records each height in.
+func (localNode *LocalNode) SetConsensusTrace(t *trace.Trace) {
+	localNode.Lock()
+	localNode.consensusTrace = t
+	localNode.Unlock()
+}
+
+// GetConsensusTrace returns the consensus trace, or nil if consensus has not
+// been created.
+func (localNode *LocalNode) GetConsensusTrace() *trace.Trace {
+	localNode.RLock()
+	defer localNode.RUnlock()
+	return localNode.consensusTrace
+}
+
+// SetEvidencePool sets the pool the consensus keeps equivocation evidence in.
+func (localNode *LocalNode) SetEvidencePool(p *evidence.Pool) {
+	localNode.Lock()
+	localNode.evidencePool = p
+	localNode.Unlock()
+}
+
+// GetEvidencePool returns the equivocation evidence pool, or nil if consensus
+// has not been created.
+func (localNode *LocalNode) GetEvidencePool() *evidence.Pool {
+	localNode.RLock()
+	defer localNode.RUnlock()
+	return localNode.evidencePool
+}
+
 func (localNode *LocalNode) GetTxnPool() *pool.TxnPool {
 	return localNode.TxnPool
 }
@@ -226,7 +226,7 @@ func (localNode *LocalNode) remoteMessageRouted(remoteMessage *nnetnode.RemoteMe
 		if nnetLocalNode != nil {
 			if len(remoteMessage.Msg.ReplyToId) == 0 { // non-reply msg
 				var reply []byte
-				reply, err = localNode.receiveMessage(senderNode, unsignedMsg)
+				reply, err = localNode.receiveMessage(senderNode, signedMsg, unsignedMsg)
 				if err != nil {
 					log.Warningf("Error handling msg: %v", err)
 					return nil, nil, nil, false
@@ -259,10 +259,12 @@ func (localNode *LocalNode) remoteMessageRouted(remoteMessage *nnetnode.RemoteMe
 	return remoteMessage, nnetLocalNode, remoteNodes, true
 }
 
-func (localNode *LocalNode) receiveMessage(sender *Node, unsignedMsg *pb.UnsignedMessage) ([]byte, error) {
+func (localNode *LocalNode) receiveMessage(sender *Node, signedMsg *pb.SignedMessage, unsignedMsg *pb.UnsignedMessage) ([]byte, error) {
 	remoteMessage := &RemoteMessage{
-		Sender:  sender,
-		Message: unsignedMsg.Message,
+		Sender:    sender,
+		Message:   unsignedMsg.Message,
+		Signed:    signedMsg.Message,
+		Signature: signedMsg.Signature,
 	}
 
 	var reply []byte
@@ -20,6 +20,8 @@ type Node struct {
 	sync.RWMutex
 	syncState           pb.SyncState
 	minVerifiableHeight uint32
+	voteNonce           uint32
+	hasVoteNonce        bool
 }
 
 func (n *Node) MarshalJSON() ([]byte, error) {
@@ -116,3 +118,15 @@ func (n *Node) SetMinVerifiableHeight(height uint32) {
 	defer n.Unlock()
 	n.minVerifiableHeight = height
 }
+
+// PinVoteNonce records the vote nonce of the node the first time it is called
+// and returns whether nonce is the recorded one. A node is created for each
+// connection, so the nonce is pinned for the connected session.
+func (n *Node) PinVoteNonce(nonce uint32) bool {
+	n.Lock()
+	defer n.Unlock()
+	if !n.hasVoteNonce {
+		n.voteNonce, n.hasVoteNonce = nonce, true
+	}
+	return n.voteNonce == nonce
+}
@@ -13,9 +13,10 @@ import (
 
 type RemoteNode struct {
 	*Node
-	localNode *LocalNode
-	nnetNode  *nnetnode.RemoteNode
-	sharedKey *[sharedKeySize]byte
+	localNode  *LocalNode
+	nnetNode   *nnetnode.RemoteNode
+	sharedKey  *[sharedKeySize]byte
+	reputation *Reputation
 
 	sync.RWMutex
 	height uint32
@@ -37,6 +38,7 @@ func (remoteNode *RemoteNode) MarshalJSON() ([]byte, error) {
 	out["height"] = remoteNode.GetHeight()
 	out["isOutbound"] = remoteNode.nnetNode.IsOutbound
 	out["roundTripTime"] = remoteNode.nnetNode.GetRoundTripTime() / time.Millisecond
+	out["reputation"] = remoteNode.GetReputation()
 
 	return json.Marshal(out)
 }
@@ -62,10 +64,11 @@ func NewRemoteNode(localNode *LocalNode, nnetNode *nnetnode.RemoteNode) (*Remote
 	}
 
 	remoteNode := &RemoteNode{
-		Node:      node,
-		localNode: localNode,
-		nnetNode:  nnetNode,
-		sharedKey: sharedKey,
+		Node:       node,
+		localNode:  localNode,
+		nnetNode:   nnetNode,
+		sharedKey:  sharedKey,
+		reputation: NewReputation(time.Now()),
 	}
 
 	return remoteNode, nil
@@ -0,0 +1,109 @@
+package node
+
+import (
+	"sync"
+	"time"
+
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/util/config"
+)
+
+const (
+	reputationInitialRate = 0.5                           // rate before any history
+	reputationDecay       = 0.1                           // weight of the latest sample in rates
+	reputationFullUptime  = 30 * config.ConsensusDuration // uptime that gets full uptime score
+)
+
+// Reputation is how much a neighbor can be trusted in consensus, built from
+// its history since connected.
+type Reputation struct {
+	sync.RWMutex
+	connectTime       time.Time
+	voteAgreementRate float64 // moving average of votes agreeing with persisted blocks
+	validProposalRate float64 // moving average of signed proposals passing verification
+}
+
+// NewReputation creates the reputation of a neighbor connected at connectTime.
+func NewReputation(connectTime time.Time) *Reputation {
+	return &Reputation{
+		connectTime:       connectTime,
+		voteAgreementRate: reputationInitialRate,
+		validProposalRate: reputationInitialRate,
+	}
+}
+
+func updateRate(rate float64, ok bool) float64 {
+	sample := 0.0
+	if ok {
+		sample = 1
+	}
+	return rate*(1-reputationDecay) + sample*reputationDecay
+}
+
+// ReputationInfo is the reputation of a neighbor and what it is built from.
+type ReputationInfo struct {
+	Score             float64 `json:"score"`
+	VoteAgreementRate float64 `json:"voteAgreementRate"`
+	ValidProposalRate float64 `json:"validProposalRate"`
+	Uptime            float64 `json:"uptime"` // in seconds
+	Synced            bool    `json:"synced"`
+}
+
+// UpdateVoteAgreement records if the vote of the neighbor at a height agreed
+// with the block persisted at that height.
+func (r *Reputation) UpdateVoteAgreement(agreed bool) {
+	r.Lock()
+	r.voteAgreementRate = updateRate(r.voteAgreementRate, agreed)
+	r.Unlock()
+}
+
+// UpdateProposalValidity records if a block proposal signed by the neighbor
+// passed verification.
+func (r *Reputation) UpdateProposalValidity(valid bool) {
+	r.Lock()
+	r.validProposalRate = updateRate(r.validProposalRate, valid)
+	r.Unlock()
+}
+
+// Info returns the reputation at time now. Score is between 0 and 1, the mean
+// of vote agreement rate, valid proposal rate and uptime score, or 0 if the
+// neighbor has not finished syncing.
+func (r *Reputation) Info(now time.Time, synced bool) *ReputationInfo {
+	r.RLock()
+	defer r.RUnlock()
+
+	uptime := now.Sub(r.connectTime)
+	uptimeScore := float64(uptime) / float64(reputationFullUptime)
+	if uptimeScore > 1 {
+		uptimeScore = 1
+	}
+
+	info := &ReputationInfo{
+		VoteAgreementRate: r.voteAgreementRate,
+		ValidProposalRate: r.validProposalRate,
+		Uptime:            uptime.Seconds(),
+		Synced:            synced,
+	}
+	if synced {
+		info.Score = (info.VoteAgreementRate + info.ValidProposalRate + uptimeScore) / 3
+	}
+
+	return info
+}
+
+// UpdateVoteAgreement records if the vote of the neighbor at a height agreed
+// with the block persisted at that height.
+func (remoteNode *RemoteNode) UpdateVoteAgreement(agreed bool) {
+	remoteNode.reputation.UpdateVoteAgreement(agreed)
+}
+
+// UpdateProposalValidity records if a block proposal signed by the neighbor
+// passed verification.
+func (remoteNode *RemoteNode) UpdateProposalValidity(valid bool) {
+	remoteNode.reputation.UpdateProposalValidity(valid)
+}
+
+// GetReputation returns the reputation of the neighbor.
+func (remoteNode *RemoteNode) GetReputation() *ReputationInfo {
+	return remoteNode.reputation.Info(time.Now(), remoteNode.GetSyncState() == pb.PERSIST_FINISHED)
+}
@@ -0,0 +1,77 @@
+package node
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/nknorg/nkn/crypto"
+	"github.com/nknorg/nkn/pb"
+	nnetpb "github.com/nknorg/nnet/protobuf"
+)
+
+func TestReputationInfo(t *testing.T) {
+	connectTime := time.Unix(0, 0)
+	r := NewReputation(connectTime)
+
+	info := r.Info(connectTime, false)
+	if info.Score != 0 || info.Synced {
+		t.Fatalf("got score %v of a neighbor not synced, want 0", info.Score)
+	}
+	if info.VoteAgreementRate != reputationInitialRate || info.ValidProposalRate != reputationInitialRate {
+		t.Fatalf("got rates %v and %v, want %v", info.VoteAgreementRate, info.ValidProposalRate, reputationInitialRate)
+	}
+
+	r.UpdateVoteAgreement(true)
+	r.UpdateProposalValidity(false)
+	voteRate := reputationInitialRate*(1-reputationDecay) + reputationDecay
+	proposalRate := reputationInitialRate * (1 - reputationDecay)
+
+	tests := []struct {
+		uptime      time.Duration
+		uptimeScore float64
+	}{
+		{0, 0},
+		{reputationFullUptime / 2, 0.5},
+		{reputationFullUptime, 1},
+		{2 * reputationFullUptime, 1},
+	}
+	for _, test := range tests {
+		info = r.Info(connectTime.Add(test.uptime), true)
+		want := (voteRate + proposalRate + test.uptimeScore) / 3
+		if math.Abs(info.Score-want) > 1e-9 {
+			t.Errorf("uptime %v: got score %v, want %v", test.uptime, info.Score, want)
+		}
+		if info.Uptime != test.uptime.Seconds() {
+			t.Errorf("uptime %v: got uptime %v", test.uptime, info.Uptime)
+		}
+	}
+}
+
+func TestGetReputation(t *testing.T) {
+	_, pubKey, err := crypto.GenKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err := NewNode(&nnetpb.Node{Id: []byte{1}}, &pb.NodeData{PublicKey: pubKey.EncodePoint()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	remoteNode := &RemoteNode{
+		Node:       n,
+		reputation: NewReputation(time.Now().Add(-reputationFullUptime)),
+	}
+
+	if info := remoteNode.GetReputation(); info.Synced || info.Score != 0 {
+		t.Fatalf("got score %v before the neighbor is synced, want 0", info.Score)
+	}
+
+	remoteNode.SetSyncState(pb.PERSIST_FINISHED)
+	for i := 0; i < 100; i++ {
+		remoteNode.UpdateVoteAgreement(true)
+		remoteNode.UpdateProposalValidity(true)
+	}
+	if info := remoteNode.GetReputation(); !info.Synced || info.Score < 0.99 {
+		t.Fatalf("got score %v of a synced neighbor with full history, want 1", info.Score)
+	}
+}
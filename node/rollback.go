@@ -8,6 +8,7 @@ import (
 	"github.com/nknorg/nkn/block"
 	"github.com/nknorg/nkn/chain"
 	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/transaction"
 	"github.com/nknorg/nkn/util/config"
 	"github.com/nknorg/nkn/util/log"
 )
@@ -30,7 +31,7 @@ func (localNode *LocalNode) maybeRollback(neighbors []*RemoteNode) (bool, error)
 
 	if majorityBlockHash != currentHash {
 		err := fmt.Errorf("Local block hash %s is different from neighbors' majority block hash %s at height %d, rollback needed", currentHash.ToHexString(), majorityBlockHash.ToHexString(), currentHeight)
-		if config.MaxRollbackBlocks == 0 {
+		if config.Parameters.MaxRollbackDepth == 0 {
 			return false, err
 		}
 		log.Warning(err)
@@ -38,22 +39,19 @@ func (localNode *LocalNode) maybeRollback(neighbors []*RemoteNode) (bool, error)
 		return false, nil
 	}
 
-	var rollbackToHeight uint32
-	if currentHeight > config.MaxRollbackBlocks {
-		rollbackToHeight = currentHeight - config.MaxRollbackBlocks
-	} else {
-		rollbackToHeight = 0
-	}
-
-	majorityBlockHash = localNode.getNeighborsMajorityBlockHashByHeight(rollbackToHeight, neighbors)
-	if majorityBlockHash == common.EmptyUint256 {
-		return false, fmt.Errorf("get neighbors majority block hash at rollback height failed")
+	rollbackToHeight, err := localNode.findForkHeight(currentHeight, neighbors)
+	if err != nil {
+		return false, err
 	}
 
-	rollbackHash, _ := chain.DefaultLedger.Store.GetBlockHash(rollbackToHeight)
-	if majorityBlockHash != rollbackHash {
-		return false, fmt.Errorf("local ledger has forked for more than %d blocks", config.MaxRollbackBlocks)
-	}
+	// txns of blocks already rolled back are readded even if a later block
+	// fails to roll back
+	txns := make([]*transaction.Transaction, 0)
+	defer func() {
+		if len(txns) > 0 {
+			localNode.GetTxnPool().ReaddTransactions(txns)
+		}
+	}()
 
 	for rollbackHeight := currentHeight; rollbackHeight > rollbackToHeight; rollbackHeight-- {
 		block, err := chain.DefaultLedger.Store.GetBlockByHeight(rollbackHeight)
@@ -64,13 +62,68 @@ func (localNode *LocalNode) maybeRollback(neighbors []*RemoteNode) (bool, error)
 		if err != nil {
 			return false, fmt.Errorf("ledger rollback error: %v", err)
 		}
+		txns = append(block.Transactions, txns...)
 	}
 
-	log.Infof("Rollback to block height %d", rollbackToHeight)
+	log.Infof("Rollback %d blocks to block height %d", currentHeight-rollbackToHeight, rollbackToHeight)
 
 	return true, nil
 }
 
+// isMajorityBlockHash returns if the local block hash at a given height is the
+// same as the majority of given neighbors' block hash
+func (localNode *LocalNode) isMajorityBlockHash(height uint32, neighbors []*RemoteNode) (bool, error) {
+	majorityBlockHash := localNode.getNeighborsMajorityBlockHashByHeight(height, neighbors)
+	if majorityBlockHash == common.EmptyUint256 {
+		return false, fmt.Errorf("get neighbors majority block hash at height %d failed", height)
+	}
+
+	localHash, err := chain.DefaultLedger.Store.GetBlockHash(height)
+	if err != nil {
+		return false, err
+	}
+
+	return majorityBlockHash == localHash, nil
+}
+
+// findForkHeight returns the highest block height below currentHeight at
+// which local ledger agrees with the majority of given neighbors, searching at
+// most MaxRollbackDepth blocks back and not below the snapshot height.
+func (localNode *LocalNode) findForkHeight(currentHeight uint32, neighbors []*RemoteNode) (uint32, error) {
+	var low uint32
+	if currentHeight > config.Parameters.MaxRollbackDepth {
+		low = currentHeight - config.Parameters.MaxRollbackDepth
+	}
+	if snapshotHeight := chain.DefaultLedger.Store.GetSnapshotHeight(); low < snapshotHeight {
+		low = snapshotHeight
+	}
+
+	agreed, err := localNode.isMajorityBlockHash(low, neighbors)
+	if err != nil {
+		return 0, err
+	}
+	if !agreed {
+		return 0, fmt.Errorf("local ledger has forked at or below height %d", low)
+	}
+
+	// local ledger agrees with neighbors at low but not at high
+	high := currentHeight
+	for high-low > 1 {
+		mid := low + (high-low)/2
+		agreed, err = localNode.isMajorityBlockHash(mid, neighbors)
+		if err != nil {
+			return 0, err
+		}
+		if agreed {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+
+	return low, nil
+}
+
 // getNeighborsBlockHeaderByHeight returns the block header at a given height
 // from given neighbors by calling GetBlockHeaders on all of them concurrently.
 func (localNode *LocalNode) getNeighborsBlockHeaderByHeight(height uint32, neighbors []*RemoteNode) (*sync.Map, error) {
@@ -96,7 +96,7 @@ var MessageType_value = map[string]int32{
 }
 
 func (MessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{0}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{0}
 }
 
 // Message type that can be signed message
@@ -105,17 +105,20 @@ type AllowedSignedMessageType int32
 
 const (
 	ALLOW_SIGNED_PLACEHOLDER_DO_NOT_USE AllowedSignedMessageType = 0
+	ALLOW_SIGNED_VOTE                   AllowedSignedMessageType = 1
 )
 
 var AllowedSignedMessageType_name = map[int32]string{
 	0: "ALLOW_SIGNED_PLACEHOLDER_DO_NOT_USE",
+	1: "ALLOW_SIGNED_VOTE",
 }
 var AllowedSignedMessageType_value = map[string]int32{
 	"ALLOW_SIGNED_PLACEHOLDER_DO_NOT_USE": 0,
+	"ALLOW_SIGNED_VOTE":                   1,
 }
 
 func (AllowedSignedMessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{1}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{1}
 }
 
 // Message type that can be unsigned message
@@ -188,7 +191,7 @@ var AllowedUnsignedMessageType_value = map[string]int32{
 }
 
 func (AllowedUnsignedMessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{2}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{2}
 }
 
 // Message type that can be sent as direct message
@@ -255,7 +258,7 @@ var AllowedDirectMessageType_value = map[string]int32{
 }
 
 func (AllowedDirectMessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{3}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{3}
 }
 
 // Message type that can be sent as relay message
@@ -277,7 +280,7 @@ var AllowedRelayMessageType_value = map[string]int32{
 }
 
 func (AllowedRelayMessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{4}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{4}
 }
 
 // Message type that can be sent as broadcast_push message
@@ -299,7 +302,7 @@ var AllowedBroadcastPushMessageType_value = map[string]int32{
 }
 
 func (AllowedBroadcastPushMessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{5}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{5}
 }
 
 // Message type that can be sent as broadcast_pull message
@@ -318,7 +321,7 @@ var AllowedBroadcastPullMessageType_value = map[string]int32{
 }
 
 func (AllowedBroadcastPullMessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{6}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{6}
 }
 
 // Message type that can be sent as broadcast_tree message
@@ -340,7 +343,7 @@ var AllowedBroadcastTreeMessageType_value = map[string]int32{
 }
 
 func (AllowedBroadcastTreeMessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{7}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{7}
 }
 
 type RequestTransactionType int32
@@ -363,7 +366,7 @@ var RequestTransactionType_value = map[string]int32{
 }
 
 func (RequestTransactionType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{8}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{8}
 }
 
 type UnsignedMessage struct {
@@ -374,7 +377,7 @@ type UnsignedMessage struct {
 func (m *UnsignedMessage) Reset()      { *m = UnsignedMessage{} }
 func (*UnsignedMessage) ProtoMessage() {}
 func (*UnsignedMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{0}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{0}
 }
 func (m *UnsignedMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +428,7 @@ type SignedMessage struct {
 func (m *SignedMessage) Reset()      { *m = SignedMessage{} }
 func (*SignedMessage) ProtoMessage() {}
 func (*SignedMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{1}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{1}
 }
 func (m *SignedMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -471,12 +474,14 @@ func (m *SignedMessage) GetSignature() []byte {
 type Vote struct {
 	Height    uint32 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	BlockHash []byte `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Seq       uint32 `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	Nonce     uint32 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{2}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{2}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,6 +524,20 @@ func (m *Vote) GetBlockHash() []byte {
 	return nil
 }
 
+func (m *Vote) GetSeq() uint32 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *Vote) GetNonce() uint32 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 type IHaveBlockProposal struct {
 	Height    uint32 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	BlockHash []byte `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
@@ -527,7 +546,7 @@ type IHaveBlockProposal struct {
 func (m *IHaveBlockProposal) Reset()      { *m = IHaveBlockProposal{} }
 func (*IHaveBlockProposal) ProtoMessage() {}
 func (*IHaveBlockProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{3}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{3}
 }
 func (m *IHaveBlockProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +599,7 @@ type RequestBlockProposal struct {
 func (m *RequestBlockProposal) Reset()      { *m = RequestBlockProposal{} }
 func (*RequestBlockProposal) ProtoMessage() {}
 func (*RequestBlockProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{4}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{4}
 }
 func (m *RequestBlockProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,7 +664,7 @@ type RequestBlockProposalReply struct {
 func (m *RequestBlockProposalReply) Reset()      { *m = RequestBlockProposalReply{} }
 func (*RequestBlockProposalReply) ProtoMessage() {}
 func (*RequestBlockProposalReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{5}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{5}
 }
 func (m *RequestBlockProposalReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -699,7 +718,7 @@ type RequestProposalTransactions struct {
 func (m *RequestProposalTransactions) Reset()      { *m = RequestProposalTransactions{} }
 func (*RequestProposalTransactions) ProtoMessage() {}
 func (*RequestProposalTransactions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{6}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{6}
 }
 func (m *RequestProposalTransactions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -770,7 +789,7 @@ type RequestProposalTransactionsReply struct {
 func (m *RequestProposalTransactionsReply) Reset()      { *m = RequestProposalTransactionsReply{} }
 func (*RequestProposalTransactionsReply) ProtoMessage() {}
 func (*RequestProposalTransactionsReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{7}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{7}
 }
 func (m *RequestProposalTransactionsReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,7 +831,7 @@ type GetConsensusState struct {
 func (m *GetConsensusState) Reset()      { *m = GetConsensusState{} }
 func (*GetConsensusState) ProtoMessage() {}
 func (*GetConsensusState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{8}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{8}
 }
 func (m *GetConsensusState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -852,7 +871,7 @@ type GetConsensusStateReply struct {
 func (m *GetConsensusStateReply) Reset()      { *m = GetConsensusStateReply{} }
 func (*GetConsensusStateReply) ProtoMessage() {}
 func (*GetConsensusStateReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{9}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{9}
 }
 func (m *GetConsensusStateReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +943,7 @@ type GetBlockHeaders struct {
 func (m *GetBlockHeaders) Reset()      { *m = GetBlockHeaders{} }
 func (*GetBlockHeaders) ProtoMessage() {}
 func (*GetBlockHeaders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{10}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{10}
 }
 func (m *GetBlockHeaders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -974,7 +993,7 @@ type GetBlockHeadersReply struct {
 func (m *GetBlockHeadersReply) Reset()      { *m = GetBlockHeadersReply{} }
 func (*GetBlockHeadersReply) ProtoMessage() {}
 func (*GetBlockHeadersReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{11}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{11}
 }
 func (m *GetBlockHeadersReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1018,7 +1037,7 @@ type GetBlocks struct {
 func (m *GetBlocks) Reset()      { *m = GetBlocks{} }
 func (*GetBlocks) ProtoMessage() {}
 func (*GetBlocks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{12}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{12}
 }
 func (m *GetBlocks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1068,7 +1087,7 @@ type GetBlocksReply struct {
 func (m *GetBlocksReply) Reset()      { *m = GetBlocksReply{} }
 func (*GetBlocksReply) ProtoMessage() {}
 func (*GetBlocksReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{13}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{13}
 }
 func (m *GetBlocksReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1120,7 +1139,7 @@ type Relay struct {
 func (m *Relay) Reset()      { *m = Relay{} }
 func (*Relay) ProtoMessage() {}
 func (*Relay) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{14}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{14}
 }
 func (m *Relay) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1212,7 +1231,7 @@ type Transactions struct {
 func (m *Transactions) Reset()      { *m = Transactions{} }
 func (*Transactions) ProtoMessage() {}
 func (*Transactions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{15}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{15}
 }
 func (m *Transactions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1256,7 +1275,7 @@ type BacktrackSignatureChain struct {
 func (m *BacktrackSignatureChain) Reset()      { *m = BacktrackSignatureChain{} }
 func (*BacktrackSignatureChain) ProtoMessage() {}
 func (*BacktrackSignatureChain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{16}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{16}
 }
 func (m *BacktrackSignatureChain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1307,7 +1326,7 @@ type IHaveSignatureChainTransaction struct {
 func (m *IHaveSignatureChainTransaction) Reset()      { *m = IHaveSignatureChainTransaction{} }
 func (*IHaveSignatureChainTransaction) ProtoMessage() {}
 func (*IHaveSignatureChainTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{17}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{17}
 }
 func (m *IHaveSignatureChainTransaction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1357,7 +1376,7 @@ type RequestSignatureChainTransaction struct {
 func (m *RequestSignatureChainTransaction) Reset()      { *m = RequestSignatureChainTransaction{} }
 func (*RequestSignatureChainTransaction) ProtoMessage() {}
 func (*RequestSignatureChainTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{18}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{18}
 }
 func (m *RequestSignatureChainTransaction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1400,7 +1419,7 @@ type RequestSignatureChainTransactionReply struct {
 func (m *RequestSignatureChainTransactionReply) Reset()      { *m = RequestSignatureChainTransactionReply{} }
 func (*RequestSignatureChainTransactionReply) ProtoMessage() {}
 func (*RequestSignatureChainTransactionReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{19}
+	return fileDescriptor_nodemessage_dd9f5a523cfa3555, []int{19}
 }
 func (m *RequestSignatureChainTransactionReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1609,6 +1628,12 @@ func (this *Vote) Equal(that interface{}) bool {
 	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
 		return false
 	}
+	if this.Seq != that1.Seq {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
 	return true
 }
 func (this *IHaveBlockProposal) Equal(that interface{}) bool {
@@ -2149,10 +2174,12 @@ func (this *Vote) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&pb.Vote{")
 	s = append(s, "Height: "+fmt.Sprintf("%#v", this.Height)+",\n")
 	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	s = append(s, "Seq: "+fmt.Sprintf("%#v", this.Seq)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2456,6 +2483,16 @@ func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintNodemessage(dAtA, i, uint64(len(m.BlockHash)))
 		i += copy(dAtA[i:], m.BlockHash)
 	}
+	if m.Seq != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNodemessage(dAtA, i, uint64(m.Seq))
+	}
+	if m.Nonce != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintNodemessage(dAtA, i, uint64(m.Nonce))
+	}
 	return i, nil
 }
 
@@ -3078,6 +3115,8 @@ func NewPopulatedVote(r randyNodemessage, easy bool) *Vote {
 	for i := 0; i < v4; i++ {
 		this.BlockHash[i] = byte(r.Intn(256))
 	}
+	this.Seq = uint32(r.Uint32())
+	this.Nonce = uint32(r.Uint32())
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3466,6 +3505,12 @@ func (m *Vote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNodemessage(uint64(l))
 	}
+	if m.Seq != 0 {
+		n += 1 + sovNodemessage(uint64(m.Seq))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovNodemessage(uint64(m.Nonce))
+	}
 	return n
 }
 
@@ -3822,6 +3867,8 @@ func (this *Vote) String() string {
 	s := strings.Join([]string{`&Vote{`,
 		`Height:` + fmt.Sprintf("%v", this.Height) + `,`,
 		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`Seq:` + fmt.Sprintf("%v", this.Seq) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4318,6 +4365,44 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				m.BlockHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodemessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodemessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNodemessage(dAtA[iNdEx:])
@@ -6340,113 +6425,114 @@ var (
 	ErrIntOverflowNodemessage   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("pb/nodemessage.proto", fileDescriptor_nodemessage_c02ceb21668725d0) }
+func init() { proto.RegisterFile("pb/nodemessage.proto", fileDescriptor_nodemessage_dd9f5a523cfa3555) }
 
-var fileDescriptor_nodemessage_c02ceb21668725d0 = []byte{
-	// 1676 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_nodemessage_dd9f5a523cfa3555 = []byte{
+	// 1687 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x3d, 0x73, 0xdb, 0xc8,
-	0x19, 0x16, 0xf4, 0x65, 0xf3, 0x15, 0x29, 0x81, 0x6b, 0xd9, 0xa2, 0x65, 0x0b, 0x96, 0xe0, 0x93,
-	0x23, 0xf3, 0x7c, 0xe2, 0x9d, 0x9c, 0xdc, 0xdc, 0x64, 0x2e, 0x05, 0x48, 0x21, 0x22, 0xc7, 0x34,
-	0xc9, 0x00, 0x90, 0x2f, 0x4e, 0x83, 0x01, 0xc9, 0x35, 0x89, 0x31, 0x08, 0x30, 0x58, 0xc8, 0x31,
-	0x5d, 0xe5, 0x27, 0xa4, 0xce, 0x2f, 0x48, 0x9f, 0xc9, 0x4c, 0x7e, 0x42, 0xd2, 0xb9, 0xbc, 0x32,
-	0xa6, 0x9b, 0x24, 0xd5, 0x55, 0x99, 0x94, 0x19, 0x2c, 0x16, 0x10, 0x08, 0x02, 0x94, 0xed, 0x49,
-	0x71, 0x9d, 0xf6, 0x7d, 0x9f, 0xf7, 0x73, 0x9f, 0x67, 0xc1, 0x11, 0x6c, 0x8f, 0xbb, 0x15, 0xdb,
-	0xe9, 0xe3, 0x11, 0x26, 0xc4, 0x18, 0xe0, 0xe3, 0xb1, 0xeb, 0x78, 0x0e, 0x5a, 0x1e, 0x77, 0x77,
-	0xbf, 0x18, 0x98, 0xde, 0xf0, 0xa2, 0x7b, 0xdc, 0x73, 0x46, 0x95, 0x81, 0x33, 0x70, 0x2a, 0xd4,
-	0xd5, 0xbd, 0x78, 0x41, 0x4f, 0xf4, 0x40, 0xff, 0x0a, 0x42, 0x76, 0x0b, 0x2c, 0x11, 0x3b, 0x16,
-	0xc7, 0xdd, 0x0a, 0x31, 0x07, 0xbd, 0xa1, 0x61, 0xda, 0xcc, 0xb4, 0x39, 0xee, 0x56, 0xba, 0x96,
-	0xd3, 0x7b, 0xc9, 0xce, 0x7e, 0x69, 0xcf, 0x35, 0x6c, 0x62, 0xf4, 0x3c, 0xd3, 0x61, 0x28, 0x51,
-	0x87, 0xad, 0x73, 0x9b, 0x98, 0x03, 0x1b, 0xf7, 0x9f, 0x06, 0x3d, 0xa1, 0x13, 0xc8, 0xb3, 0xf6,
-	0x74, 0x6f, 0x32, 0xc6, 0x25, 0x6e, 0x9f, 0x3b, 0xda, 0x3c, 0xd9, 0x3a, 0x1e, 0x77, 0x8f, 0x19,
-	0x44, 0x9b, 0x8c, 0xb1, 0xb2, 0x31, 0xba, 0x3c, 0xa0, 0x12, 0x5c, 0x63, 0xc7, 0xd2, 0xf2, 0x3e,
-	0x77, 0x94, 0x57, 0xc2, 0xa3, 0x78, 0x06, 0x05, 0x75, 0x26, 0x7d, 0x0c, 0xca, 0xcd, 0x40, 0xd1,
-	0x5d, 0xc8, 0xf9, 0x9d, 0x18, 0xde, 0x85, 0x1b, 0xa6, 0xb9, 0x34, 0x88, 0xbf, 0x80, 0xd5, 0x67,
-	0x8e, 0x87, 0xd1, 0x2d, 0x58, 0x1f, 0x62, 0x73, 0x30, 0xf4, 0x68, 0x78, 0x41, 0x61, 0x27, 0xb4,
-	0x07, 0x40, 0xc7, 0xd5, 0x87, 0x06, 0x19, 0x86, 0xe1, 0xd4, 0x52, 0x37, 0xc8, 0x50, 0x7c, 0x02,
-	0xa8, 0x51, 0x37, 0x5e, 0xe1, 0xaa, 0x6f, 0xe9, 0xb8, 0xce, 0xd8, 0x21, 0x86, 0xf5, 0xa9, 0xc9,
-	0xfe, 0xc2, 0xc1, 0xb6, 0x82, 0x7f, 0x7b, 0x81, 0x89, 0x37, 0x9b, 0x6f, 0x36, 0x8e, 0x4b, 0xc4,
-	0xa1, 0x63, 0x58, 0xa5, 0x2b, 0x5d, 0xa6, 0x2b, 0xdd, 0xf5, 0x57, 0xca, 0xd2, 0x68, 0x97, 0x37,
-	0x43, 0xb7, 0x4b, 0x71, 0xe8, 0x01, 0x6c, 0x91, 0xa1, 0xe3, 0x7a, 0x34, 0x9d, 0x4e, 0x0c, 0xcb,
-	0x2b, 0xad, 0xd0, 0x9c, 0x05, 0x6a, 0xf6, 0x73, 0xaa, 0x86, 0xe5, 0x25, 0x71, 0xe6, 0x1b, 0x5c,
-	0x5a, 0xa5, 0xf3, 0xc4, 0x70, 0xe6, 0x1b, 0x2c, 0x9a, 0x70, 0x3b, 0xad, 0x6d, 0x05, 0x8f, 0xad,
-	0x09, 0xba, 0x07, 0x6b, 0xb4, 0x53, 0xda, 0xf6, 0xc6, 0x49, 0xce, 0xef, 0x8e, 0xc2, 0x94, 0xc0,
-	0x8e, 0x3e, 0x87, 0x62, 0x8c, 0x40, 0x24, 0xdc, 0xcd, 0xca, 0x51, 0x5e, 0xe1, 0xe3, 0x0e, 0xba,
-	0xa2, 0x7f, 0x71, 0x70, 0x87, 0xd5, 0x0a, 0xcb, 0xc4, 0x66, 0x24, 0x3f, 0xf2, 0x4d, 0xa5, 0xcf,
-	0xba, 0x96, 0x31, 0xeb, 0x77, 0xb0, 0xbf, 0x60, 0xd4, 0x60, 0xbb, 0x8f, 0x21, 0x1f, 0x8f, 0x2b,
-	0x71, 0xfb, 0x2b, 0x47, 0x1b, 0x81, 0xaa, 0x62, 0x60, 0x65, 0x06, 0x24, 0xde, 0x80, 0xe2, 0x19,
-	0xf6, 0x6a, 0x8e, 0x4d, 0xb0, 0x4d, 0x2e, 0x88, 0xea, 0x19, 0x1e, 0x16, 0xff, 0xc3, 0xc1, 0xad,
-	0x39, 0x6b, 0x50, 0xe4, 0x3e, 0x14, 0x2c, 0xdc, 0x1f, 0x60, 0x57, 0x9f, 0x61, 0x75, 0x3e, 0x30,
-	0xd6, 0x03, 0x6e, 0x97, 0xa1, 0xc8, 0x40, 0x73, 0x14, 0xdf, 0x0a, 0x1c, 0xd5, 0xe8, 0x1a, 0x1e,
-	0x02, 0xdf, 0x0b, 0xeb, 0x84, 0x39, 0x57, 0x68, 0xce, 0xad, 0xc8, 0xce, 0xd2, 0x3e, 0x02, 0x20,
-	0x13, 0xbb, 0xa7, 0x13, 0xbf, 0x1d, 0xba, 0xd4, 0xcd, 0x93, 0x82, 0x3f, 0x9e, 0x3a, 0xb1, 0x7b,
-	0x41, 0x8f, 0x39, 0x12, 0xfe, 0x89, 0x4e, 0xe0, 0xe6, 0xc8, 0xb4, 0xf5, 0x57, 0xd8, 0x35, 0x5f,
-	0x98, 0x46, 0xd7, 0xc2, 0x61, 0xf6, 0x35, 0x9a, 0xfd, 0xc6, 0xc8, 0xb4, 0x9f, 0x45, 0xbe, 0xa0,
-	0x82, 0xa8, 0xc2, 0xd6, 0x19, 0x0e, 0x98, 0x5b, 0xc7, 0x46, 0x1f, 0xbb, 0x04, 0x1d, 0x40, 0x9e,
-	0x78, 0x86, 0x7f, 0x9d, 0xf1, 0x79, 0x37, 0xa8, 0xad, 0x1e, 0x49, 0x19, 0xdb, 0xfd, 0x10, 0xb0,
-	0x4c, 0x01, 0x39, 0x6c, 0xf7, 0x59, 0xd2, 0x33, 0xd8, 0x4e, 0x24, 0x0d, 0x56, 0x59, 0x81, 0x02,
-	0x5b, 0x4f, 0x60, 0x65, 0x17, 0x06, 0xfe, 0x44, 0x01, 0x50, 0xc9, 0x77, 0x63, 0x51, 0xe2, 0x53,
-	0xc8, 0x85, 0x89, 0xfe, 0x1f, 0x7d, 0x3d, 0x86, 0xcd, 0x28, 0x5d, 0xd0, 0xd1, 0x01, 0xac, 0xd3,
-	0x82, 0x61, 0x2b, 0x31, 0x81, 0x32, 0x87, 0xf8, 0xc7, 0x65, 0x58, 0x53, 0xb0, 0x65, 0x4c, 0xd0,
-	0x21, 0x6c, 0x12, 0xb7, 0xa7, 0x9b, 0x7d, 0x6c, 0x7b, 0xe6, 0x0b, 0x13, 0xbb, 0xb4, 0x85, 0x9c,
-	0x52, 0x20, 0x6e, 0xaf, 0x11, 0x19, 0xd1, 0x0e, 0x5c, 0xeb, 0x63, 0xe2, 0xe9, 0x66, 0x9f, 0x31,
-	0x60, 0xdd, 0x3f, 0x36, 0xfa, 0xfe, 0x2b, 0x3d, 0x36, 0x26, 0x96, 0x63, 0xf4, 0x99, 0x8e, 0xc2,
-	0x23, 0x3a, 0x86, 0x1b, 0x23, 0xe3, 0xb5, 0x3e, 0x74, 0xac, 0xbe, 0x69, 0x0f, 0x74, 0x82, 0x7b,
-	0x8e, 0xdd, 0x27, 0xec, 0xde, 0x8a, 0x23, 0xe3, 0x75, 0x3d, 0xf0, 0xa8, 0x81, 0xc3, 0x9f, 0xd3,
-	0xef, 0x64, 0x7c, 0xd1, 0x7d, 0x89, 0x27, 0xa5, 0x75, 0xf6, 0xac, 0xbb, 0xbd, 0x0e, 0x35, 0x24,
-	0xde, 0x81, 0x6b, 0xc9, 0x77, 0xe0, 0x10, 0x36, 0x2d, 0x83, 0x78, 0xfa, 0xe5, 0x87, 0xe1, 0x7a,
-	0x20, 0x6b, 0xdf, 0xaa, 0x86, 0x46, 0x24, 0x42, 0x81, 0x98, 0x03, 0x9d, 0x7e, 0xff, 0x74, 0x0b,
-	0xdb, 0xa5, 0x1c, 0x5b, 0xb8, 0x39, 0xa8, 0xf9, 0xb6, 0x26, 0xb6, 0xc5, 0x1a, 0xe4, 0x67, 0x5e,
-	0xa0, 0x4f, 0x52, 0xe4, 0x1b, 0xd8, 0xa9, 0x1a, 0xbd, 0x97, 0x9e, 0x6b, 0xf4, 0x5e, 0x46, 0xe5,
-	0x69, 0x09, 0xf4, 0x0d, 0x6c, 0x5d, 0xf6, 0x80, 0x2d, 0x3c, 0x0a, 0x53, 0xf2, 0x54, 0x05, 0xac,
-	0x13, 0xd9, 0xc2, 0x23, 0xa5, 0x40, 0x62, 0x27, 0xe2, 0x0f, 0x39, 0x76, 0xf1, 0x2b, 0x3d, 0xf9,
-	0xf5, 0x2b, 0xf8, 0xd6, 0xa8, 0x8a, 0xa8, 0x83, 0x40, 0x3f, 0x61, 0xb3, 0x75, 0x63, 0xbd, 0x66,
-	0x7e, 0xce, 0x7c, 0x36, 0x84, 0x41, 0x71, 0xbd, 0x17, 0x22, 0x2b, 0x7d, 0xc7, 0x1a, 0xd1, 0x3b,
-	0x96, 0x5d, 0x62, 0x3e, 0x15, 0x97, 0x96, 0xea, 0x37, 0x70, 0x78, 0x55, 0xaa, 0x80, 0xd5, 0x5f,
-	0xc1, 0x46, 0x6c, 0xc1, 0xec, 0xdb, 0x33, 0x77, 0x09, 0x71, 0x4c, 0xf9, 0xcf, 0xab, 0xb0, 0x11,
-	0xfb, 0x25, 0x82, 0x7e, 0x02, 0xf7, 0x9f, 0xca, 0xaa, 0x2a, 0x9d, 0xc9, 0xba, 0xf6, 0xbc, 0x23,
-	0xeb, 0x9d, 0xa6, 0x54, 0x93, 0xeb, 0xed, 0xe6, 0xa9, 0xac, 0xe8, 0xa7, 0x6d, 0xbd, 0xd5, 0xd6,
-	0xf4, 0x73, 0x55, 0xe6, 0x97, 0xd0, 0x75, 0x58, 0x7d, 0xd6, 0xd6, 0x64, 0x9e, 0x43, 0xb7, 0xe1,
-	0x66, 0x43, 0xaf, 0x4b, 0xcf, 0x64, 0xbd, 0xda, 0x6c, 0xd7, 0x9e, 0xe8, 0x1d, 0xa5, 0xdd, 0x69,
-	0xab, 0x52, 0x93, 0x5f, 0x46, 0xbb, 0x70, 0x4b, 0x91, 0x7f, 0x75, 0x2e, 0xab, 0x5a, 0xd2, 0xb7,
-	0x82, 0xf6, 0xe1, 0x6e, 0xba, 0x4f, 0x57, 0xe4, 0x4e, 0xf3, 0x39, 0xbf, 0x8a, 0x76, 0xe0, 0xc6,
-	0x99, 0xac, 0xe9, 0xb5, 0x76, 0x4b, 0x95, 0x5b, 0xea, 0xb9, 0xaa, 0xab, 0x9a, 0xa4, 0xc9, 0xfc,
-	0x1a, 0xda, 0x83, 0xdb, 0x29, 0x0e, 0x16, 0xb7, 0x8e, 0x6e, 0x42, 0xd1, 0x77, 0x07, 0x59, 0xeb,
-	0xb2, 0x74, 0x2a, 0x2b, 0x2a, 0x7f, 0x0d, 0xdd, 0x81, 0x9d, 0x39, 0x33, 0x8b, 0xb9, 0x8e, 0x36,
-	0x01, 0x22, 0xa7, 0xca, 0xe7, 0xd0, 0x36, 0xf0, 0x97, 0x67, 0x86, 0x02, 0x94, 0x83, 0x35, 0x45,
-	0x6e, 0x4a, 0xcf, 0xf9, 0x0d, 0xc4, 0x43, 0x5e, 0x53, 0xa4, 0x96, 0x2a, 0xd5, 0xb4, 0x46, 0xbb,
-	0xa5, 0xf2, 0x79, 0xbf, 0xab, 0xaa, 0x54, 0x7b, 0xa2, 0x29, 0x52, 0xed, 0x89, 0xae, 0x36, 0xce,
-	0x5a, 0x92, 0x76, 0xae, 0xc8, 0x7a, 0xad, 0x2e, 0x35, 0x5a, 0x7c, 0x01, 0x1d, 0xc0, 0x5e, 0x38,
-	0x6f, 0x34, 0xe9, 0x4c, 0x86, 0x4d, 0x7f, 0xf9, 0x0b, 0x21, 0xac, 0x8f, 0x2d, 0xf4, 0x00, 0x44,
-	0xb6, 0xf2, 0x44, 0x9d, 0x38, 0x9c, 0xe7, 0xe3, 0x09, 0x17, 0x01, 0x8b, 0xe8, 0x0b, 0x78, 0xf8,
-	0x01, 0x40, 0x56, 0x1f, 0x95, 0x6b, 0x50, 0x92, 0x2c, 0xcb, 0xf9, 0x1d, 0xee, 0xcf, 0xfc, 0x1e,
-	0x0d, 0x19, 0x24, 0x35, 0x9b, 0xed, 0xef, 0x68, 0x22, 0xf9, 0x34, 0x93, 0x41, 0xe5, 0xbf, 0xaf,
-	0xc3, 0x2e, 0xcb, 0x92, 0xf8, 0xd9, 0x4c, 0xf3, 0x3c, 0x84, 0xc3, 0x20, 0xcf, 0x79, 0xeb, 0x8a,
-	0x4c, 0x3e, 0x51, 0x12, 0x50, 0x46, 0xcd, 0x23, 0xf8, 0x2c, 0xe1, 0xc8, 0x62, 0xea, 0x7c, 0xb5,
-	0x4c, 0xe2, 0x3e, 0x00, 0x71, 0x21, 0x34, 0xa4, 0xef, 0x3c, 0x2e, 0x9d, 0xcd, 0x8f, 0xe0, 0xe8,
-	0x6a, 0x5c, 0x44, 0xee, 0xcf, 0x60, 0x3f, 0x05, 0x9d, 0xe4, 0x7a, 0x19, 0x1e, 0x5c, 0x85, 0x8a,
-	0xa8, 0xbf, 0x07, 0xb7, 0xb3, 0xb0, 0xbe, 0x12, 0xee, 0xc3, 0xbd, 0x4c, 0x77, 0x24, 0x8c, 0x12,
-	0x6c, 0xcf, 0xed, 0x24, 0xd0, 0xc9, 0x3d, 0xb8, 0x93, 0xf0, 0x24, 0x64, 0x33, 0x3f, 0xfe, 0x22,
-	0x15, 0x7d, 0x09, 0x8f, 0x32, 0x96, 0x9f, 0x25, 0xaa, 0xaf, 0xe1, 0xe4, 0x63, 0x22, 0x22, 0x8d,
-	0xfd, 0x0c, 0xbe, 0x4a, 0xe7, 0xce, 0x62, 0xc9, 0x65, 0x97, 0x5b, 0xac, 0xc0, 0x6f, 0xe1, 0x9b,
-	0x8f, 0x8f, 0x8b, 0x04, 0xf9, 0xef, 0xb5, 0x48, 0x91, 0xa7, 0xa6, 0x8b, 0x7b, 0x5e, 0xaa, 0x22,
-	0x4f, 0x1b, 0x8a, 0x5c, 0xd3, 0xb2, 0x75, 0x74, 0x13, 0x8a, 0x33, 0x40, 0xa6, 0xa2, 0x88, 0xc8,
-	0xcc, 0x9c, 0xa5, 0xa1, 0x64, 0x9d, 0x4c, 0x05, 0x45, 0x1c, 0x4e, 0x05, 0x86, 0xfa, 0x49, 0xa2,
-	0xd2, 0xd5, 0x13, 0x31, 0x3d, 0x1b, 0x15, 0x69, 0x47, 0x04, 0x61, 0x0e, 0x9b, 0x54, 0x4e, 0xf4,
-	0x64, 0x64, 0x61, 0x22, 0xdd, 0xdc, 0x81, 0x9d, 0x74, 0xa4, 0xaf, 0x9a, 0x03, 0xd8, 0xcb, 0x70,
-	0x46, 0x9a, 0x49, 0x76, 0xbe, 0x88, 0xf6, 0xc7, 0x50, 0x4e, 0xdd, 0x58, 0x16, 0xe9, 0x7f, 0x0a,
-	0x5f, 0x7e, 0x38, 0x3e, 0xa2, 0xfc, 0x63, 0xa8, 0xa4, 0x5d, 0xf4, 0x62, 0xc2, 0x67, 0x95, 0x5a,
-	0x4c, 0xf7, 0x9f, 0xc3, 0xd7, 0x1f, 0x1b, 0x15, 0x91, 0xfd, 0xd7, 0xb0, 0xc3, 0xb8, 0x4e, 0x7f,
-	0x9f, 0xc7, 0xa9, 0x1e, 0x51, 0x95, 0x3e, 0x3f, 0x1f, 0xc0, 0xf4, 0x00, 0xc7, 0x1e, 0xab, 0xf2,
-	0x04, 0xee, 0xb1, 0xcc, 0x55, 0xd7, 0x31, 0xfa, 0x3d, 0x83, 0x78, 0x9d, 0x0b, 0x32, 0x8c, 0x57,
-	0xa8, 0xc0, 0xe7, 0x41, 0x64, 0x55, 0x69, 0x4b, 0xa7, 0x35, 0xc9, 0x5f, 0xea, 0xb9, 0x5a, 0xcf,
-	0x2e, 0x75, 0x08, 0x07, 0xa9, 0x01, 0xb3, 0xcf, 0x60, 0x59, 0x49, 0x2b, 0x6d, 0x59, 0x57, 0x96,
-	0x6e, 0x36, 0xb3, 0xbf, 0xb0, 0x29, 0xe3, 0x68, 0x2e, 0xc6, 0x57, 0xe4, 0xd4, 0x14, 0x59, 0xfe,
-	0xa8, 0x71, 0x68, 0x40, 0x62, 0x9c, 0xd7, 0x70, 0x2b, 0xfd, 0x7f, 0x0c, 0xe8, 0x2e, 0x94, 0xc2,
-	0xcb, 0xfe, 0xa5, 0xdf, 0x7d, 0x9c, 0x17, 0x4b, 0x71, 0x6f, 0xfc, 0xea, 0xeb, 0x92, 0x5a, 0xe7,
-	0x39, 0x5f, 0xc0, 0x69, 0x5e, 0xb5, 0xde, 0x56, 0xb4, 0x00, 0xb3, 0x5c, 0xfd, 0xf6, 0xed, 0x3b,
-	0x61, 0xe9, 0xfb, 0x77, 0xc2, 0xd2, 0x0f, 0xef, 0x04, 0xee, 0xbf, 0xef, 0x04, 0xee, 0xf7, 0x53,
-	0x81, 0xfb, 0xd3, 0x54, 0xe0, 0xfe, 0x3a, 0x15, 0xb8, 0xbf, 0x4d, 0x05, 0xee, 0xed, 0x54, 0xe0,
-	0xfe, 0x31, 0x15, 0xb8, 0x7f, 0x4e, 0x85, 0xa5, 0x1f, 0xa6, 0x02, 0xf7, 0x87, 0xf7, 0xc2, 0xd2,
-	0xdb, 0xf7, 0xc2, 0xd2, 0xf7, 0xef, 0x85, 0xa5, 0xee, 0x3a, 0xfd, 0x57, 0xde, 0xe3, 0xff, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x7e, 0x2d, 0xb6, 0xc0, 0x5d, 0x14, 0x00, 0x00,
+	0x19, 0x16, 0xf4, 0x65, 0xf3, 0x15, 0x29, 0x41, 0x2b, 0xd9, 0xa2, 0x65, 0x0b, 0x96, 0xe0, 0x93,
+	0x23, 0xeb, 0x7c, 0xe2, 0x9d, 0x9c, 0xdc, 0xdc, 0x64, 0xae, 0x01, 0x29, 0x84, 0xe4, 0x98, 0x26,
+	0x19, 0x00, 0xf2, 0xc5, 0xd7, 0x60, 0x40, 0x72, 0x4d, 0x62, 0x0c, 0x02, 0x3c, 0x2c, 0xe4, 0x98,
+	0xae, 0xf2, 0x13, 0x52, 0xe7, 0x17, 0xa4, 0xcf, 0x64, 0x26, 0x3f, 0x21, 0xe9, 0x5c, 0x5e, 0x19,
+	0xd3, 0x4d, 0x92, 0xea, 0xaa, 0x4c, 0xca, 0x0c, 0x16, 0x0b, 0x08, 0x04, 0x01, 0xca, 0xf2, 0xa4,
+	0x48, 0xc7, 0x7d, 0xdf, 0xe7, 0xfd, 0xc4, 0xf3, 0x2c, 0x30, 0x84, 0xed, 0x51, 0xa7, 0x64, 0x3b,
+	0x3d, 0x3c, 0xc4, 0x84, 0x18, 0x7d, 0x7c, 0x32, 0x72, 0x1d, 0xcf, 0x41, 0x8b, 0xa3, 0xce, 0xee,
+	0x17, 0x7d, 0xd3, 0x1b, 0x5c, 0x74, 0x4e, 0xba, 0xce, 0xb0, 0xd4, 0x77, 0xfa, 0x4e, 0x89, 0xba,
+	0x3a, 0x17, 0x2f, 0xe9, 0x89, 0x1e, 0xe8, 0xaf, 0x20, 0x64, 0xb7, 0xc0, 0x12, 0xb1, 0xe3, 0xe6,
+	0xa8, 0x53, 0x22, 0x66, 0xbf, 0x3b, 0x30, 0x4c, 0x9b, 0x99, 0xd6, 0x47, 0x9d, 0x52, 0xc7, 0x72,
+	0xba, 0xaf, 0xd8, 0xd9, 0x2f, 0xed, 0xb9, 0x86, 0x4d, 0x8c, 0xae, 0x67, 0x3a, 0x0c, 0x25, 0xea,
+	0xb0, 0x71, 0x6e, 0x13, 0xb3, 0x6f, 0xe3, 0xde, 0xb3, 0xa0, 0x27, 0x74, 0x0a, 0x79, 0xd6, 0x9e,
+	0xee, 0x8d, 0x47, 0xb8, 0xc8, 0xed, 0x73, 0x47, 0xeb, 0xa7, 0x1b, 0x27, 0xa3, 0xce, 0x09, 0x83,
+	0x68, 0xe3, 0x11, 0x56, 0xd6, 0x86, 0x97, 0x07, 0x54, 0x84, 0x1b, 0xec, 0x58, 0x5c, 0xdc, 0xe7,
+	0x8e, 0xf2, 0x4a, 0x78, 0x14, 0xab, 0x50, 0x50, 0xa7, 0xd2, 0xc7, 0xa0, 0xdc, 0x14, 0x14, 0xdd,
+	0x83, 0x9c, 0xdf, 0x89, 0xe1, 0x5d, 0xb8, 0x61, 0x9a, 0x4b, 0x83, 0xd8, 0x82, 0xe5, 0xe7, 0x8e,
+	0x87, 0xd1, 0x6d, 0x58, 0x1d, 0x60, 0xb3, 0x3f, 0xf0, 0x68, 0x78, 0x41, 0x61, 0x27, 0xb4, 0x07,
+	0x40, 0xc7, 0xd5, 0x07, 0x06, 0x19, 0x84, 0xe1, 0xd4, 0x52, 0x33, 0xc8, 0x00, 0xf1, 0xb0, 0x44,
+	0xf0, 0x0f, 0xc5, 0x25, 0x1a, 0xe3, 0xff, 0x14, 0x9f, 0x02, 0xaa, 0xd7, 0x8c, 0xd7, 0xb8, 0xec,
+	0x63, 0xda, 0xae, 0x33, 0x72, 0x88, 0x61, 0x7d, 0x62, 0x7a, 0xf1, 0xcf, 0x1c, 0x6c, 0x2b, 0xf8,
+	0x87, 0x0b, 0x4c, 0xbc, 0xe9, 0x7c, 0xd3, 0x71, 0x5c, 0xb2, 0xad, 0x13, 0x58, 0xa6, 0x4b, 0x5e,
+	0xa4, 0x4b, 0xde, 0xf5, 0x97, 0xcc, 0xd2, 0x68, 0x97, 0xcf, 0x8a, 0xee, 0x9b, 0xe2, 0xd0, 0x43,
+	0xd8, 0x20, 0x03, 0xc7, 0xf5, 0x68, 0x3a, 0x9d, 0x18, 0x96, 0x47, 0x47, 0xca, 0x2b, 0x05, 0x6a,
+	0xf6, 0x73, 0xaa, 0x86, 0xe5, 0x25, 0x71, 0xe6, 0x5b, 0x5c, 0x5c, 0xa6, 0xf3, 0xc4, 0x70, 0xe6,
+	0x5b, 0x2c, 0x9a, 0x70, 0x27, 0xad, 0x6d, 0x05, 0x8f, 0xac, 0x31, 0xba, 0x0f, 0x2b, 0xb4, 0x53,
+	0xda, 0xf6, 0xda, 0x69, 0xce, 0xef, 0x8e, 0xc2, 0x94, 0xc0, 0x8e, 0x3e, 0x87, 0xcd, 0x18, 0xa5,
+	0x48, 0xb8, 0x9b, 0xa5, 0xa3, 0xbc, 0xc2, 0xc7, 0x1d, 0x74, 0x45, 0xff, 0xe4, 0xe0, 0x2e, 0xab,
+	0x15, 0x96, 0x89, 0xcd, 0x48, 0xfe, 0xcf, 0x37, 0x95, 0x3e, 0xeb, 0x4a, 0xc6, 0xac, 0xdf, 0xc1,
+	0xfe, 0x9c, 0x51, 0x83, 0xed, 0x3e, 0x81, 0x7c, 0x3c, 0xae, 0xc8, 0xed, 0x2f, 0x1d, 0xad, 0x05,
+	0x3a, 0x8b, 0x81, 0x95, 0x29, 0x90, 0xb8, 0x05, 0x9b, 0x55, 0xec, 0x55, 0x1c, 0x9b, 0x60, 0x9b,
+	0x5c, 0x10, 0xd5, 0x33, 0x3c, 0x2c, 0xfe, 0x9b, 0x83, 0xdb, 0x33, 0xd6, 0xa0, 0xc8, 0x03, 0x28,
+	0x58, 0xb8, 0xd7, 0xc7, 0xae, 0x3e, 0xc5, 0xea, 0x7c, 0x60, 0xac, 0x51, 0x1b, 0x3a, 0x86, 0x4d,
+	0x06, 0x9a, 0xa1, 0xf8, 0x46, 0xe0, 0x28, 0x47, 0x8f, 0xe1, 0x11, 0xf0, 0xdd, 0xb0, 0x4e, 0x98,
+	0x33, 0x10, 0xd5, 0x46, 0x64, 0x67, 0x69, 0x1f, 0x03, 0x90, 0xb1, 0xdd, 0xd5, 0x89, 0xdf, 0x0e,
+	0x5d, 0xea, 0xfa, 0x69, 0xc1, 0x1f, 0x4f, 0x1d, 0xdb, 0xdd, 0xa0, 0xc7, 0x1c, 0x09, 0x7f, 0xa2,
+	0x53, 0xb8, 0x35, 0x34, 0x6d, 0xfd, 0x35, 0x76, 0xcd, 0x97, 0xa6, 0xd1, 0xb1, 0x70, 0x98, 0x7d,
+	0x85, 0x66, 0xdf, 0x1a, 0x9a, 0xf6, 0xf3, 0xc8, 0x17, 0x54, 0x10, 0x55, 0xd8, 0xa8, 0xe2, 0x80,
+	0xb9, 0x35, 0x6c, 0xf4, 0xb0, 0x4b, 0xd0, 0x01, 0xe4, 0x89, 0x67, 0xf8, 0x8f, 0x33, 0x3e, 0xef,
+	0x1a, 0xb5, 0xd5, 0x22, 0x29, 0x63, 0xbb, 0x17, 0x02, 0x16, 0x29, 0x20, 0x87, 0xed, 0x1e, 0x4b,
+	0x5a, 0x85, 0xed, 0x44, 0xd2, 0x60, 0x95, 0x25, 0x28, 0xb0, 0xf5, 0x04, 0x56, 0xf6, 0xc0, 0xc0,
+	0x9f, 0x28, 0x00, 0x2a, 0xf9, 0x4e, 0x2c, 0x4a, 0x7c, 0x06, 0xb9, 0x30, 0xd1, 0xff, 0xa2, 0xaf,
+	0x27, 0xb0, 0x1e, 0xa5, 0x0b, 0x3a, 0x3a, 0x80, 0x55, 0x5a, 0x30, 0x6c, 0x25, 0x26, 0x50, 0xe6,
+	0x10, 0xff, 0xb0, 0x08, 0x2b, 0x0a, 0xb6, 0x8c, 0x31, 0x3a, 0x84, 0x75, 0xe2, 0x76, 0x75, 0xb3,
+	0x87, 0x6d, 0xcf, 0x7c, 0x69, 0x62, 0x97, 0xb6, 0x90, 0x53, 0x0a, 0xc4, 0xed, 0xd6, 0x23, 0x23,
+	0xda, 0x81, 0x1b, 0x3d, 0x4c, 0x3c, 0xdd, 0xec, 0x31, 0x06, 0xac, 0xfa, 0xc7, 0x7a, 0xcf, 0xbf,
+	0xb7, 0x47, 0xc6, 0xd8, 0x72, 0x8c, 0x1e, 0xd3, 0x51, 0x78, 0x44, 0x27, 0xb0, 0x35, 0x34, 0xde,
+	0xe8, 0x03, 0xc7, 0xea, 0x99, 0x76, 0x5f, 0x27, 0xb8, 0xeb, 0xd8, 0x3d, 0xc2, 0x9e, 0xdb, 0xe6,
+	0xd0, 0x78, 0x53, 0x0b, 0x3c, 0x6a, 0xe0, 0xf0, 0xe7, 0xf4, 0x3b, 0x19, 0x5d, 0x74, 0x5e, 0xe1,
+	0x71, 0x71, 0x95, 0x5d, 0xf4, 0x6e, 0xb7, 0x4d, 0x0d, 0x89, 0x7b, 0xe0, 0x46, 0xf2, 0x1e, 0x38,
+	0x84, 0x75, 0xcb, 0x20, 0x9e, 0x7e, 0xf9, 0xaa, 0xb8, 0x19, 0xc8, 0xda, 0xb7, 0xaa, 0xa1, 0x11,
+	0x89, 0x50, 0x20, 0x66, 0x5f, 0xa7, 0x6f, 0x44, 0xdd, 0xc2, 0x76, 0x31, 0xc7, 0x16, 0x6e, 0xf6,
+	0x2b, 0xbe, 0xad, 0x81, 0x6d, 0xb1, 0x02, 0xf9, 0xa9, 0x1b, 0xe8, 0x93, 0x14, 0xf9, 0x16, 0x76,
+	0xca, 0x46, 0xf7, 0x95, 0xe7, 0x1a, 0xdd, 0x57, 0x51, 0x79, 0x5a, 0x02, 0x7d, 0x03, 0x1b, 0x97,
+	0x3d, 0x60, 0x0b, 0x0f, 0xc3, 0x94, 0x3c, 0x55, 0x01, 0xeb, 0x44, 0xb6, 0xf0, 0x50, 0x29, 0x90,
+	0xd8, 0x89, 0xf8, 0x43, 0x8e, 0x5c, 0xfc, 0x5a, 0x4f, 0xbe, 0x0f, 0x0b, 0xbe, 0x35, 0xaa, 0x22,
+	0xea, 0x20, 0xd0, 0x57, 0xd8, 0x74, 0xdd, 0x58, 0xaf, 0x99, 0xaf, 0x33, 0x9f, 0x0d, 0x61, 0x50,
+	0x5c, 0xef, 0x85, 0xc8, 0x4a, 0xef, 0xb1, 0x7a, 0x74, 0x8f, 0x65, 0x97, 0x98, 0x4d, 0xc5, 0xa5,
+	0xa5, 0xfa, 0x1e, 0x0e, 0xaf, 0x4a, 0x15, 0xb0, 0xfa, 0x2b, 0x58, 0x8b, 0x2d, 0x98, 0xbd, 0x7b,
+	0x66, 0x1e, 0x42, 0x1c, 0x73, 0xfc, 0xa7, 0x65, 0x58, 0x8b, 0x7d, 0x9b, 0xa0, 0x9f, 0xc1, 0x83,
+	0x67, 0xb2, 0xaa, 0x4a, 0x55, 0x59, 0xd7, 0x5e, 0xb4, 0x65, 0xbd, 0xdd, 0x90, 0x2a, 0x72, 0xad,
+	0xd5, 0x38, 0x93, 0x15, 0xfd, 0xac, 0xa5, 0x37, 0x5b, 0x9a, 0x7e, 0xae, 0xca, 0xfc, 0x02, 0xba,
+	0x09, 0xcb, 0xcf, 0x5b, 0x9a, 0xcc, 0x73, 0xe8, 0x0e, 0xdc, 0xaa, 0xeb, 0x35, 0xe9, 0xb9, 0xac,
+	0x97, 0x1b, 0xad, 0xca, 0x53, 0xbd, 0xad, 0xb4, 0xda, 0x2d, 0x55, 0x6a, 0xf0, 0x8b, 0x68, 0x17,
+	0x6e, 0x2b, 0xf2, 0xaf, 0xcf, 0x65, 0x55, 0x4b, 0xfa, 0x96, 0xd0, 0x3e, 0xdc, 0x4b, 0xf7, 0xe9,
+	0x8a, 0xdc, 0x6e, 0xbc, 0xe0, 0x97, 0xd1, 0x0e, 0x6c, 0x55, 0x65, 0x4d, 0xaf, 0xb4, 0x9a, 0xaa,
+	0xdc, 0x54, 0xcf, 0x55, 0x5d, 0xd5, 0x24, 0x4d, 0xe6, 0x57, 0xd0, 0x1e, 0xdc, 0x49, 0x71, 0xb0,
+	0xb8, 0x55, 0x74, 0x0b, 0x36, 0xab, 0x72, 0x98, 0xb5, 0x26, 0x4b, 0x67, 0xb2, 0xa2, 0xf2, 0x37,
+	0xd0, 0x5d, 0xd8, 0x99, 0x31, 0xb3, 0x98, 0x9b, 0x68, 0x1d, 0x20, 0x72, 0xaa, 0x7c, 0x0e, 0x6d,
+	0x03, 0x7f, 0x79, 0x66, 0x28, 0x40, 0x39, 0x58, 0x51, 0xe4, 0x86, 0xf4, 0x82, 0x5f, 0x43, 0x3c,
+	0xe4, 0x35, 0x45, 0x6a, 0xaa, 0x52, 0x45, 0xab, 0xb7, 0x9a, 0x2a, 0x9f, 0xf7, 0xbb, 0x2a, 0x4b,
+	0x95, 0xa7, 0x9a, 0x22, 0x55, 0x9e, 0xea, 0x6a, 0xbd, 0xda, 0x94, 0xb4, 0x73, 0x45, 0xd6, 0x2b,
+	0x35, 0xa9, 0xde, 0xe4, 0x0b, 0xe8, 0x00, 0xf6, 0xc2, 0x79, 0xa3, 0x49, 0xa7, 0x32, 0xac, 0xfb,
+	0xcb, 0x9f, 0x0b, 0x61, 0x7d, 0x6c, 0xa0, 0x87, 0x20, 0xb2, 0x95, 0x27, 0xea, 0xc4, 0xe1, 0x3c,
+	0x1f, 0x4f, 0x38, 0x0f, 0xb8, 0x89, 0xbe, 0x80, 0x47, 0x1f, 0x01, 0x64, 0xf5, 0xd1, 0xf1, 0xf7,
+	0x50, 0x94, 0x2c, 0xcb, 0xf9, 0x2d, 0xee, 0x4d, 0x7d, 0xa1, 0x86, 0x0c, 0x92, 0x1a, 0x8d, 0xd6,
+	0x77, 0x34, 0x91, 0x7c, 0x96, 0xcd, 0xa0, 0x5b, 0xb0, 0x39, 0x05, 0x0c, 0xe8, 0x74, 0xfc, 0xb7,
+	0x55, 0xd8, 0x65, 0xc9, 0x13, 0xdf, 0xd7, 0x34, 0xfd, 0x23, 0x38, 0x0c, 0xa2, 0xce, 0x9b, 0x57,
+	0x15, 0xd8, 0x81, 0xad, 0x04, 0x94, 0x31, 0xf6, 0x08, 0x3e, 0x4b, 0x38, 0xb2, 0x08, 0x3c, 0x5b,
+	0x2d, 0x93, 0xcf, 0x0f, 0x41, 0x9c, 0x0b, 0x0d, 0x59, 0x3d, 0x8b, 0x4b, 0x27, 0xf9, 0x63, 0x38,
+	0xba, 0x1a, 0x17, 0x71, 0xfe, 0x33, 0xd8, 0x4f, 0x41, 0x27, 0x25, 0x70, 0x0c, 0x0f, 0xaf, 0x42,
+	0x45, 0x8a, 0xd8, 0x83, 0x3b, 0x59, 0x58, 0x5f, 0x20, 0x0f, 0xe0, 0x7e, 0xa6, 0x3b, 0xd2, 0x4b,
+	0x11, 0xb6, 0x67, 0x76, 0x12, 0xc8, 0xe7, 0x3e, 0xdc, 0x4d, 0x78, 0x12, 0x6a, 0x9a, 0x1d, 0x7f,
+	0x9e, 0xb8, 0xbe, 0x84, 0xc7, 0x19, 0xcb, 0xcf, 0xd2, 0xda, 0xd7, 0x70, 0x7a, 0x9d, 0x88, 0x48,
+	0x7a, 0xbf, 0x80, 0xaf, 0xd2, 0xb9, 0x33, 0x5f, 0x89, 0xd9, 0xe5, 0xe6, 0x0b, 0xf3, 0x5b, 0xf8,
+	0xe6, 0xfa, 0x71, 0x91, 0x4e, 0xff, 0xb5, 0x12, 0x09, 0xf5, 0xcc, 0x74, 0x71, 0xd7, 0x4b, 0x15,
+	0xea, 0x59, 0x5d, 0x91, 0x2b, 0xda, 0x47, 0x08, 0x95, 0x01, 0x99, 0x8a, 0x22, 0x22, 0x33, 0x73,
+	0x96, 0x86, 0x92, 0x75, 0x32, 0x15, 0x14, 0x71, 0x38, 0x15, 0x18, 0xea, 0x27, 0x89, 0x4a, 0x57,
+	0x4f, 0xc4, 0xf4, 0x6c, 0x54, 0xa4, 0x1d, 0x11, 0x84, 0x19, 0x6c, 0x52, 0x39, 0xd1, 0x95, 0x91,
+	0x85, 0x89, 0x74, 0x73, 0x17, 0x76, 0xd2, 0x91, 0xbe, 0x6a, 0x0e, 0x60, 0x2f, 0xc3, 0x19, 0x69,
+	0x26, 0xd9, 0xf9, 0x3c, 0xda, 0x9f, 0xc0, 0x71, 0xea, 0xc6, 0xb2, 0x48, 0xff, 0x73, 0xf8, 0xf2,
+	0xe3, 0xf1, 0x11, 0xe5, 0x9f, 0x40, 0x29, 0xed, 0x41, 0xcf, 0x27, 0x7c, 0x56, 0xa9, 0xf9, 0x74,
+	0xff, 0x25, 0x7c, 0x7d, 0xdd, 0xa8, 0x88, 0xec, 0xbf, 0x81, 0x1d, 0xc6, 0x75, 0xfa, 0xd9, 0x1e,
+	0xa7, 0x7a, 0x44, 0x55, 0x7a, 0xfd, 0x7c, 0x04, 0xd3, 0x03, 0x1c, 0xbb, 0xac, 0x8e, 0xc7, 0x70,
+	0x9f, 0x65, 0x2e, 0xbb, 0x8e, 0xd1, 0xeb, 0x1a, 0xc4, 0x6b, 0x5f, 0x90, 0x41, 0xbc, 0x42, 0x09,
+	0x3e, 0x0f, 0x22, 0xcb, 0x4a, 0x4b, 0x3a, 0xab, 0x48, 0xfe, 0x52, 0xcf, 0xd5, 0x5a, 0x76, 0xa9,
+	0x43, 0x38, 0x48, 0x0d, 0x98, 0xbe, 0x06, 0x8f, 0x95, 0xb4, 0xd2, 0x96, 0x75, 0x65, 0xe9, 0x46,
+	0x23, 0xb3, 0x74, 0xda, 0x38, 0x9a, 0x8b, 0xf1, 0x15, 0x39, 0x35, 0x45, 0x96, 0xaf, 0x35, 0x0e,
+	0x0d, 0x48, 0x8c, 0xf3, 0x06, 0x6e, 0xa7, 0xff, 0xf5, 0x80, 0xee, 0x41, 0x31, 0x7c, 0xd8, 0xbf,
+	0xf2, 0xbb, 0x8f, 0xf3, 0x62, 0x21, 0xee, 0x8d, 0x39, 0xf4, 0x9a, 0xa4, 0xd6, 0x78, 0xce, 0x17,
+	0x70, 0x9a, 0x57, 0xad, 0xb5, 0x14, 0x2d, 0xc0, 0x2c, 0x96, 0xbf, 0x7d, 0xf7, 0x5e, 0x58, 0xf8,
+	0xf1, 0xbd, 0xb0, 0xf0, 0xd3, 0x7b, 0x81, 0xfb, 0xcf, 0x7b, 0x81, 0xfb, 0xdd, 0x44, 0xe0, 0xfe,
+	0x38, 0x11, 0xb8, 0xbf, 0x4c, 0x04, 0xee, 0xaf, 0x13, 0x81, 0x7b, 0x37, 0x11, 0xb8, 0xbf, 0x4f,
+	0x04, 0xee, 0x1f, 0x13, 0x61, 0xe1, 0xa7, 0x89, 0xc0, 0xfd, 0xfe, 0x83, 0xb0, 0xf0, 0xee, 0x83,
+	0xb0, 0xf0, 0xe3, 0x07, 0x61, 0xa1, 0xb3, 0x4a, 0xff, 0xf3, 0x7b, 0xf2, 0xdf, 0x01, 0x00, 0xff,
+	0x7f, 0xe0, 0xc9, 0x86, 0x14, 0x00, 0x00,
 }
@@ -31,17 +31,18 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type PayloadType int32
 
 const (
-	COINBASE_TYPE       PayloadType = 0
-	TRANSFER_ASSET_TYPE PayloadType = 1
-	SIG_CHAIN_TXN_TYPE  PayloadType = 2
-	REGISTER_NAME_TYPE  PayloadType = 3
-	TRANSFER_NAME_TYPE  PayloadType = 4
-	DELETE_NAME_TYPE    PayloadType = 5
-	SUBSCRIBE_TYPE      PayloadType = 6
-	UNSUBSCRIBE_TYPE    PayloadType = 7
-	GENERATE_ID_TYPE    PayloadType = 8
-	NANO_PAY_TYPE       PayloadType = 9
-	ISSUE_ASSET_TYPE    PayloadType = 10
+	COINBASE_TYPE             PayloadType = 0
+	TRANSFER_ASSET_TYPE       PayloadType = 1
+	SIG_CHAIN_TXN_TYPE        PayloadType = 2
+	REGISTER_NAME_TYPE        PayloadType = 3
+	TRANSFER_NAME_TYPE        PayloadType = 4
+	DELETE_NAME_TYPE          PayloadType = 5
+	SUBSCRIBE_TYPE            PayloadType = 6
+	UNSUBSCRIBE_TYPE          PayloadType = 7
+	GENERATE_ID_TYPE          PayloadType = 8
+	NANO_PAY_TYPE             PayloadType = 9
+	ISSUE_ASSET_TYPE          PayloadType = 10
+	BATCH_TRANSFER_ASSET_TYPE PayloadType = 11
 )
 
 var PayloadType_name = map[int32]string{
@@ -56,36 +57,39 @@ var PayloadType_name = map[int32]string{
 	8:  "GENERATE_ID_TYPE",
 	9:  "NANO_PAY_TYPE",
 	10: "ISSUE_ASSET_TYPE",
+	11: "BATCH_TRANSFER_ASSET_TYPE",
 }
 var PayloadType_value = map[string]int32{
-	"COINBASE_TYPE":       0,
-	"TRANSFER_ASSET_TYPE": 1,
-	"SIG_CHAIN_TXN_TYPE":  2,
-	"REGISTER_NAME_TYPE":  3,
-	"TRANSFER_NAME_TYPE":  4,
-	"DELETE_NAME_TYPE":    5,
-	"SUBSCRIBE_TYPE":      6,
-	"UNSUBSCRIBE_TYPE":    7,
-	"GENERATE_ID_TYPE":    8,
-	"NANO_PAY_TYPE":       9,
-	"ISSUE_ASSET_TYPE":    10,
+	"COINBASE_TYPE":             0,
+	"TRANSFER_ASSET_TYPE":       1,
+	"SIG_CHAIN_TXN_TYPE":        2,
+	"REGISTER_NAME_TYPE":        3,
+	"TRANSFER_NAME_TYPE":        4,
+	"DELETE_NAME_TYPE":          5,
+	"SUBSCRIBE_TYPE":            6,
+	"UNSUBSCRIBE_TYPE":          7,
+	"GENERATE_ID_TYPE":          8,
+	"NANO_PAY_TYPE":             9,
+	"ISSUE_ASSET_TYPE":          10,
+	"BATCH_TRANSFER_ASSET_TYPE": 11,
 }
 
 func (PayloadType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_faefe0481866d6cb, []int{0}
+	return fileDescriptor_transaction_670618a1d69313c0, []int{0}
 }
 
 type UnsignedTx struct {
-	Payload    *Payload `protobuf:"bytes,1,opt,name=payload" json:"payload,omitempty"`
-	Nonce      uint64   `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Fee        int64    `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
-	Attributes []byte   `protobuf:"bytes,4,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Payload          *Payload `protobuf:"bytes,1,opt,name=payload" json:"payload,omitempty"`
+	Nonce            uint64   `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Fee              int64    `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	Attributes       []byte   `protobuf:"bytes,4,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	ValidUntilHeight uint32   `protobuf:"varint,5,opt,name=valid_until_height,json=validUntilHeight,proto3" json:"valid_until_height,omitempty"`
 }
 
 func (m *UnsignedTx) Reset()      { *m = UnsignedTx{} }
 func (*UnsignedTx) ProtoMessage() {}
 func (*UnsignedTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_faefe0481866d6cb, []int{0}
+	return fileDescriptor_transaction_670618a1d69313c0, []int{0}
 }
 func (m *UnsignedTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -142,6 +146,13 @@ func (m *UnsignedTx) GetAttributes() []byte {
 	return nil
 }
 
+func (m *UnsignedTx) GetValidUntilHeight() uint32 {
+	if m != nil {
+		return m.ValidUntilHeight
+	}
+	return 0
+}
+
 type Transaction struct {
 	UnsignedTx *UnsignedTx `protobuf:"bytes,1,opt,name=unsigned_tx,json=unsignedTx" json:"unsigned_tx,omitempty"`
 	Programs   []*Program  `protobuf:"bytes,2,rep,name=programs" json:"programs,omitempty"`
@@ -150,7 +161,7 @@ type Transaction struct {
 func (m *Transaction) Reset()      { *m = Transaction{} }
 func (*Transaction) ProtoMessage() {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_faefe0481866d6cb, []int{1}
+	return fileDescriptor_transaction_670618a1d69313c0, []int{1}
 }
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,7 +212,7 @@ type Program struct {
 func (m *Program) Reset()      { *m = Program{} }
 func (*Program) ProtoMessage() {}
 func (*Program) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_faefe0481866d6cb, []int{2}
+	return fileDescriptor_transaction_670618a1d69313c0, []int{2}
 }
 func (m *Program) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -252,7 +263,7 @@ type Payload struct {
 func (m *Payload) Reset()      { *m = Payload{} }
 func (*Payload) ProtoMessage() {}
 func (*Payload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_faefe0481866d6cb, []int{3}
+	return fileDescriptor_transaction_670618a1d69313c0, []int{3}
 }
 func (m *Payload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,7 +315,7 @@ type Coinbase struct {
 func (m *Coinbase) Reset()      { *m = Coinbase{} }
 func (*Coinbase) ProtoMessage() {}
 func (*Coinbase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_faefe0481866d6cb, []int{4}
+	return fileDescriptor_transaction_670618a1d69313c0, []int{4}
 }
 func (m *Coinbase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -362,7 +373,7 @@ type SigChainTxn struct {
 func (m *SigChainTxn) Reset()      { *m = SigChainTxn{} }
 func (*SigChainTxn) ProtoMessage() {}
 func (*SigChainTxn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_faefe0481866d6cb, []int{5}
+	return fileDescriptor_transaction_670618a1d69313c0, []int{5}
 }
 func (m *SigChainTxn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -413,7 +424,7 @@ type RegisterName struct {
 func (m *RegisterName) Reset()      { *m = RegisterName{} }
 func (*RegisterName) ProtoMessage() {}
 func (*RegisterName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_faefe0481866d6cb, []int{6}
+	return fileDescriptor_transaction_670618a1d69313c0, []int{6}
 }
 func (m *RegisterName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,6 +467,65 @@ func (m *RegisterName) GetName() string {
 	return ""
 }
 
+type TransferName struct {
+	Registrant []byte `protobuf:"bytes,1,opt,name=registrant,proto3" json:"registrant,omitempty"`
+	Recipient  []byte `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *TransferName) Reset()      { *m = TransferName{} }
+func (*TransferName) ProtoMessage() {}
+func (*TransferName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_670618a1d69313c0, []int{7}
+}
+func (m *TransferName) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferName.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TransferName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferName.Merge(dst, src)
+}
+func (m *TransferName) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferName) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferName proto.InternalMessageInfo
+
+func (m *TransferName) GetRegistrant() []byte {
+	if m != nil {
+		return m.Registrant
+	}
+	return nil
+}
+
+func (m *TransferName) GetRecipient() []byte {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+func (m *TransferName) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 type DeleteName struct {
 	Registrant []byte `protobuf:"bytes,1,opt,name=registrant,proto3" json:"registrant,omitempty"`
 	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -464,7 +534,7 @@ type DeleteName struct {
 func (m *DeleteName) Reset()      { *m = DeleteName{} }
 func (*DeleteName) ProtoMessage() {}
 func (*DeleteName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_faefe0481866d6cb, []int{7}
+	return fileDescriptor_transaction_670618a1d69313c0, []int{8}
 }
 func (m *DeleteName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +589,7 @@ type Subscribe struct {
 func (m *Subscribe) Reset()      { *m = Subscribe{} }
 func (*Subscribe) ProtoMessage() {}
 func (*Subscribe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_faefe0481866d6cb, []int{8}
+	return fileDescriptor_transaction_670618a1d69313c0, []int{9}
 }
 func (m *Subscribe) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -594,12 +664,13 @@ type TransferAsset struct {
 	Sender    []byte `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Recipient []byte `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	Amount    int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	AssetId   []byte `protobuf:"bytes,4,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
 }
 
 func (m *TransferAsset) Reset()      { *m = TransferAsset{} }
 func (*TransferAsset) ProtoMessage() {}
 func (*TransferAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_faefe0481866d6cb, []int{9}
+	return fileDescriptor_transaction_670618a1d69313c0, []int{10}
 }
 func (m *TransferAsset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,6 +720,13 @@ func (m *TransferAsset) GetAmount() int64 {
 	return 0
 }
 
+func (m *TransferAsset) GetAssetId() []byte {
+	if m != nil {
+		return m.AssetId
+	}
+	return nil
+}
+
 type GenerateID struct {
 	PublicKey       []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	RegistrationFee int64  `protobuf:"varint,2,opt,name=registration_fee,json=registrationFee,proto3" json:"registration_fee,omitempty"`
@@ -657,7 +735,7 @@ type GenerateID struct {
 func (m *GenerateID) Reset()      { *m = GenerateID{} }
 func (*GenerateID) ProtoMessage() {}
 func (*GenerateID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_faefe0481866d6cb, []int{10}
+	return fileDescriptor_transaction_670618a1d69313c0, []int{11}
 }
 func (m *GenerateID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -712,7 +790,7 @@ type NanoPay struct {
 func (m *NanoPay) Reset()      { *m = NanoPay{} }
 func (*NanoPay) ProtoMessage() {}
 func (*NanoPay) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_faefe0481866d6cb, []int{11}
+	return fileDescriptor_transaction_670618a1d69313c0, []int{12}
 }
 func (m *NanoPay) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -794,7 +872,7 @@ type IssueAsset struct {
 func (m *IssueAsset) Reset()      { *m = IssueAsset{} }
 func (*IssueAsset) ProtoMessage() {}
 func (*IssueAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_faefe0481866d6cb, []int{12}
+	return fileDescriptor_transaction_670618a1d69313c0, []int{13}
 }
 func (m *IssueAsset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -858,6 +936,116 @@ func (m *IssueAsset) GetPrecision() uint32 {
 	return 0
 }
 
+type TransferOutput struct {
+	Recipient []byte `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	AssetId   []byte `protobuf:"bytes,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+}
+
+func (m *TransferOutput) Reset()      { *m = TransferOutput{} }
+func (*TransferOutput) ProtoMessage() {}
+func (*TransferOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_670618a1d69313c0, []int{14}
+}
+func (m *TransferOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TransferOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferOutput.Merge(dst, src)
+}
+func (m *TransferOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferOutput proto.InternalMessageInfo
+
+func (m *TransferOutput) GetRecipient() []byte {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+func (m *TransferOutput) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TransferOutput) GetAssetId() []byte {
+	if m != nil {
+		return m.AssetId
+	}
+	return nil
+}
+
+type BatchTransferAsset struct {
+	Sender  []byte            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Outputs []*TransferOutput `protobuf:"bytes,2,rep,name=outputs" json:"outputs,omitempty"`
+}
+
+func (m *BatchTransferAsset) Reset()      { *m = BatchTransferAsset{} }
+func (*BatchTransferAsset) ProtoMessage() {}
+func (*BatchTransferAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_670618a1d69313c0, []int{15}
+}
+func (m *BatchTransferAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchTransferAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchTransferAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *BatchTransferAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTransferAsset.Merge(dst, src)
+}
+func (m *BatchTransferAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchTransferAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTransferAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchTransferAsset proto.InternalMessageInfo
+
+func (m *BatchTransferAsset) GetSender() []byte {
+	if m != nil {
+		return m.Sender
+	}
+	return nil
+}
+
+func (m *BatchTransferAsset) GetOutputs() []*TransferOutput {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*UnsignedTx)(nil), "pb.UnsignedTx")
 	proto.RegisterType((*Transaction)(nil), "pb.Transaction")
@@ -866,12 +1054,15 @@ func init() {
 	proto.RegisterType((*Coinbase)(nil), "pb.Coinbase")
 	proto.RegisterType((*SigChainTxn)(nil), "pb.SigChainTxn")
 	proto.RegisterType((*RegisterName)(nil), "pb.RegisterName")
+	proto.RegisterType((*TransferName)(nil), "pb.TransferName")
 	proto.RegisterType((*DeleteName)(nil), "pb.DeleteName")
 	proto.RegisterType((*Subscribe)(nil), "pb.Subscribe")
 	proto.RegisterType((*TransferAsset)(nil), "pb.TransferAsset")
 	proto.RegisterType((*GenerateID)(nil), "pb.GenerateID")
 	proto.RegisterType((*NanoPay)(nil), "pb.NanoPay")
 	proto.RegisterType((*IssueAsset)(nil), "pb.IssueAsset")
+	proto.RegisterType((*TransferOutput)(nil), "pb.TransferOutput")
+	proto.RegisterType((*BatchTransferAsset)(nil), "pb.BatchTransferAsset")
 	proto.RegisterEnum("pb.PayloadType", PayloadType_name, PayloadType_value)
 }
 func (x PayloadType) String() string {
@@ -912,6 +1103,9 @@ func (this *UnsignedTx) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Attributes, that1.Attributes) {
 		return false
 	}
+	if this.ValidUntilHeight != that1.ValidUntilHeight {
+		return false
+	}
 	return true
 }
 func (this *Transaction) Equal(that interface{}) bool {
@@ -1084,6 +1278,36 @@ func (this *RegisterName) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TransferName) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransferName)
+	if !ok {
+		that2, ok := that.(TransferName)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Registrant, that1.Registrant) {
+		return false
+	}
+	if !bytes.Equal(this.Recipient, that1.Recipient) {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
+}
 func (this *DeleteName) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1178,6 +1402,9 @@ func (this *TransferAsset) Equal(that interface{}) bool {
 	if this.Amount != that1.Amount {
 		return false
 	}
+	if !bytes.Equal(this.AssetId, that1.AssetId) {
+		return false
+	}
 	return true
 }
 func (this *GenerateID) Equal(that interface{}) bool {
@@ -1282,11 +1509,73 @@ func (this *IssueAsset) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TransferOutput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransferOutput)
+	if !ok {
+		that2, ok := that.(TransferOutput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Recipient, that1.Recipient) {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if !bytes.Equal(this.AssetId, that1.AssetId) {
+		return false
+	}
+	return true
+}
+func (this *BatchTransferAsset) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BatchTransferAsset)
+	if !ok {
+		that2, ok := that.(BatchTransferAsset)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Sender, that1.Sender) {
+		return false
+	}
+	if len(this.Outputs) != len(that1.Outputs) {
+		return false
+	}
+	for i := range this.Outputs {
+		if !this.Outputs[i].Equal(that1.Outputs[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *UnsignedTx) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&pb.UnsignedTx{")
 	if this.Payload != nil {
 		s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
@@ -1294,6 +1583,7 @@ func (this *UnsignedTx) GoString() string {
 	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "Fee: "+fmt.Sprintf("%#v", this.Fee)+",\n")
 	s = append(s, "Attributes: "+fmt.Sprintf("%#v", this.Attributes)+",\n")
+	s = append(s, "ValidUntilHeight: "+fmt.Sprintf("%#v", this.ValidUntilHeight)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1368,6 +1658,18 @@ func (this *RegisterName) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *TransferName) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.TransferName{")
+	s = append(s, "Registrant: "+fmt.Sprintf("%#v", this.Registrant)+",\n")
+	s = append(s, "Recipient: "+fmt.Sprintf("%#v", this.Recipient)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *DeleteName) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1398,11 +1700,12 @@ func (this *TransferAsset) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&pb.TransferAsset{")
 	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
 	s = append(s, "Recipient: "+fmt.Sprintf("%#v", this.Recipient)+",\n")
 	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
+	s = append(s, "AssetId: "+fmt.Sprintf("%#v", this.AssetId)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1446,6 +1749,31 @@ func (this *IssueAsset) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *TransferOutput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.TransferOutput{")
+	s = append(s, "Recipient: "+fmt.Sprintf("%#v", this.Recipient)+",\n")
+	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
+	s = append(s, "AssetId: "+fmt.Sprintf("%#v", this.AssetId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BatchTransferAsset) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.BatchTransferAsset{")
+	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	if this.Outputs != nil {
+		s = append(s, "Outputs: "+fmt.Sprintf("%#v", this.Outputs)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringTransaction(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1495,6 +1823,11 @@ func (m *UnsignedTx) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Attributes)))
 		i += copy(dAtA[i:], m.Attributes)
 	}
+	if m.ValidUntilHeight != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.ValidUntilHeight))
+	}
 	return i, nil
 }
 
@@ -1692,7 +2025,7 @@ func (m *RegisterName) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *DeleteName) Marshal() (dAtA []byte, err error) {
+func (m *TransferName) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1702,7 +2035,7 @@ func (m *DeleteName) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteName) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransferName) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -1713,16 +2046,22 @@ func (m *DeleteName) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Registrant)))
 		i += copy(dAtA[i:], m.Registrant)
 	}
-	if len(m.Name) > 0 {
+	if len(m.Recipient) > 0 {
 		dAtA[i] = 0x12
 		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Recipient)))
+		i += copy(dAtA[i:], m.Recipient)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x1a
+		i++
 		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Name)))
 		i += copy(dAtA[i:], m.Name)
 	}
 	return i, nil
 }
 
-func (m *Subscribe) Marshal() (dAtA []byte, err error) {
+func (m *DeleteName) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1732,16 +2071,46 @@ func (m *Subscribe) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Subscribe) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteName) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Subscriber) > 0 {
+	if len(m.Registrant) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Subscriber)))
-		i += copy(dAtA[i:], m.Subscriber)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Registrant)))
+		i += copy(dAtA[i:], m.Registrant)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
+}
+
+func (m *Subscribe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Subscribe) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subscriber) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Subscriber)))
+		i += copy(dAtA[i:], m.Subscriber)
 	}
 	if len(m.Identifier) > 0 {
 		dAtA[i] = 0x12
@@ -1806,6 +2175,12 @@ func (m *TransferAsset) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintTransaction(dAtA, i, uint64(m.Amount))
 	}
+	if len(m.AssetId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.AssetId)))
+		i += copy(dAtA[i:], m.AssetId)
+	}
 	return i, nil
 }
 
@@ -1934,6 +2309,77 @@ func (m *IssueAsset) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TransferOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferOutput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Recipient)))
+		i += copy(dAtA[i:], m.Recipient)
+	}
+	if m.Amount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Amount))
+	}
+	if len(m.AssetId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.AssetId)))
+		i += copy(dAtA[i:], m.AssetId)
+	}
+	return i, nil
+}
+
+func (m *BatchTransferAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTransferAsset) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Sender)))
+		i += copy(dAtA[i:], m.Sender)
+	}
+	if len(m.Outputs) > 0 {
+		for _, msg := range m.Outputs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func encodeVarintTransaction(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -1958,6 +2404,7 @@ func NewPopulatedUnsignedTx(r randyTransaction, easy bool) *UnsignedTx {
 	for i := 0; i < v1; i++ {
 		this.Attributes[i] = byte(r.Intn(256))
 	}
+	this.ValidUntilHeight = uint32(r.Uint32())
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1999,7 +2446,7 @@ func NewPopulatedProgram(r randyTransaction, easy bool) *Program {
 
 func NewPopulatedPayload(r randyTransaction, easy bool) *Payload {
 	this := &Payload{}
-	this.Type = PayloadType([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}[r.Intn(11)])
+	this.Type = PayloadType([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}[r.Intn(12)])
 	v5 := r.Intn(100)
 	this.Data = make([]byte, v5)
 	for i := 0; i < v5; i++ {
@@ -2061,13 +2508,31 @@ func NewPopulatedRegisterName(r randyTransaction, easy bool) *RegisterName {
 	return this
 }
 
-func NewPopulatedDeleteName(r randyTransaction, easy bool) *DeleteName {
-	this := &DeleteName{}
+func NewPopulatedTransferName(r randyTransaction, easy bool) *TransferName {
+	this := &TransferName{}
 	v11 := r.Intn(100)
 	this.Registrant = make([]byte, v11)
 	for i := 0; i < v11; i++ {
 		this.Registrant[i] = byte(r.Intn(256))
 	}
+	v12 := r.Intn(100)
+	this.Recipient = make([]byte, v12)
+	for i := 0; i < v12; i++ {
+		this.Recipient[i] = byte(r.Intn(256))
+	}
+	this.Name = string(randStringTransaction(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeleteName(r randyTransaction, easy bool) *DeleteName {
+	this := &DeleteName{}
+	v13 := r.Intn(100)
+	this.Registrant = make([]byte, v13)
+	for i := 0; i < v13; i++ {
+		this.Registrant[i] = byte(r.Intn(256))
+	}
 	this.Name = string(randStringTransaction(r))
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -2076,9 +2541,9 @@ func NewPopulatedDeleteName(r randyTransaction, easy bool) *DeleteName {
 
 func NewPopulatedSubscribe(r randyTransaction, easy bool) *Subscribe {
 	this := &Subscribe{}
-	v12 := r.Intn(100)
-	this.Subscriber = make([]byte, v12)
-	for i := 0; i < v12; i++ {
+	v14 := r.Intn(100)
+	this.Subscriber = make([]byte, v14)
+	for i := 0; i < v14; i++ {
 		this.Subscriber[i] = byte(r.Intn(256))
 	}
 	this.Identifier = string(randStringTransaction(r))
@@ -2093,20 +2558,25 @@ func NewPopulatedSubscribe(r randyTransaction, easy bool) *Subscribe {
 
 func NewPopulatedTransferAsset(r randyTransaction, easy bool) *TransferAsset {
 	this := &TransferAsset{}
-	v13 := r.Intn(100)
-	this.Sender = make([]byte, v13)
-	for i := 0; i < v13; i++ {
+	v15 := r.Intn(100)
+	this.Sender = make([]byte, v15)
+	for i := 0; i < v15; i++ {
 		this.Sender[i] = byte(r.Intn(256))
 	}
-	v14 := r.Intn(100)
-	this.Recipient = make([]byte, v14)
-	for i := 0; i < v14; i++ {
+	v16 := r.Intn(100)
+	this.Recipient = make([]byte, v16)
+	for i := 0; i < v16; i++ {
 		this.Recipient[i] = byte(r.Intn(256))
 	}
 	this.Amount = int64(r.Int63())
 	if r.Intn(2) == 0 {
 		this.Amount *= -1
 	}
+	v17 := r.Intn(100)
+	this.AssetId = make([]byte, v17)
+	for i := 0; i < v17; i++ {
+		this.AssetId[i] = byte(r.Intn(256))
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2114,9 +2584,9 @@ func NewPopulatedTransferAsset(r randyTransaction, easy bool) *TransferAsset {
 
 func NewPopulatedGenerateID(r randyTransaction, easy bool) *GenerateID {
 	this := &GenerateID{}
-	v15 := r.Intn(100)
-	this.PublicKey = make([]byte, v15)
-	for i := 0; i < v15; i++ {
+	v18 := r.Intn(100)
+	this.PublicKey = make([]byte, v18)
+	for i := 0; i < v18; i++ {
 		this.PublicKey[i] = byte(r.Intn(256))
 	}
 	this.RegistrationFee = int64(r.Int63())
@@ -2130,14 +2600,14 @@ func NewPopulatedGenerateID(r randyTransaction, easy bool) *GenerateID {
 
 func NewPopulatedNanoPay(r randyTransaction, easy bool) *NanoPay {
 	this := &NanoPay{}
-	v16 := r.Intn(100)
-	this.Sender = make([]byte, v16)
-	for i := 0; i < v16; i++ {
+	v19 := r.Intn(100)
+	this.Sender = make([]byte, v19)
+	for i := 0; i < v19; i++ {
 		this.Sender[i] = byte(r.Intn(256))
 	}
-	v17 := r.Intn(100)
-	this.Recipient = make([]byte, v17)
-	for i := 0; i < v17; i++ {
+	v20 := r.Intn(100)
+	this.Recipient = make([]byte, v20)
+	for i := 0; i < v20; i++ {
 		this.Recipient[i] = byte(r.Intn(256))
 	}
 	this.Id = uint64(uint64(r.Uint32()))
@@ -2154,9 +2624,9 @@ func NewPopulatedNanoPay(r randyTransaction, easy bool) *NanoPay {
 
 func NewPopulatedIssueAsset(r randyTransaction, easy bool) *IssueAsset {
 	this := &IssueAsset{}
-	v18 := r.Intn(100)
-	this.Sender = make([]byte, v18)
-	for i := 0; i < v18; i++ {
+	v21 := r.Intn(100)
+	this.Sender = make([]byte, v21)
+	for i := 0; i < v21; i++ {
 		this.Sender[i] = byte(r.Intn(256))
 	}
 	this.Name = string(randStringTransaction(r))
@@ -2171,6 +2641,46 @@ func NewPopulatedIssueAsset(r randyTransaction, easy bool) *IssueAsset {
 	return this
 }
 
+func NewPopulatedTransferOutput(r randyTransaction, easy bool) *TransferOutput {
+	this := &TransferOutput{}
+	v22 := r.Intn(100)
+	this.Recipient = make([]byte, v22)
+	for i := 0; i < v22; i++ {
+		this.Recipient[i] = byte(r.Intn(256))
+	}
+	this.Amount = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Amount *= -1
+	}
+	v23 := r.Intn(100)
+	this.AssetId = make([]byte, v23)
+	for i := 0; i < v23; i++ {
+		this.AssetId[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBatchTransferAsset(r randyTransaction, easy bool) *BatchTransferAsset {
+	this := &BatchTransferAsset{}
+	v24 := r.Intn(100)
+	this.Sender = make([]byte, v24)
+	for i := 0; i < v24; i++ {
+		this.Sender[i] = byte(r.Intn(256))
+	}
+	if r.Intn(10) != 0 {
+		v25 := r.Intn(5)
+		this.Outputs = make([]*TransferOutput, v25)
+		for i := 0; i < v25; i++ {
+			this.Outputs[i] = NewPopulatedTransferOutput(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyTransaction interface {
 	Float32() float32
 	Float64() float64
@@ -2190,9 +2700,9 @@ func randUTF8RuneTransaction(r randyTransaction) rune {
 	return rune(ru + 61)
 }
 func randStringTransaction(r randyTransaction) string {
-	v19 := r.Intn(100)
-	tmps := make([]rune, v19)
-	for i := 0; i < v19; i++ {
+	v26 := r.Intn(100)
+	tmps := make([]rune, v26)
+	for i := 0; i < v26; i++ {
 		tmps[i] = randUTF8RuneTransaction(r)
 	}
 	return string(tmps)
@@ -2214,11 +2724,11 @@ func randFieldTransaction(dAtA []byte, r randyTransaction, fieldNumber int, wire
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateTransaction(dAtA, uint64(key))
-		v20 := r.Int63()
+		v27 := r.Int63()
 		if r.Intn(2) == 0 {
-			v20 *= -1
+			v27 *= -1
 		}
-		dAtA = encodeVarintPopulateTransaction(dAtA, uint64(v20))
+		dAtA = encodeVarintPopulateTransaction(dAtA, uint64(v27))
 	case 1:
 		dAtA = encodeVarintPopulateTransaction(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -2263,6 +2773,9 @@ func (m *UnsignedTx) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTransaction(uint64(l))
 	}
+	if m.ValidUntilHeight != 0 {
+		n += 1 + sovTransaction(uint64(m.ValidUntilHeight))
+	}
 	return n
 }
 
@@ -2372,6 +2885,27 @@ func (m *RegisterName) Size() (n int) {
 	return n
 }
 
+func (m *TransferName) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Registrant)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	return n
+}
+
 func (m *DeleteName) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2437,6 +2971,10 @@ func (m *TransferAsset) Size() (n int) {
 	if m.Amount != 0 {
 		n += 1 + sovTransaction(uint64(m.Amount))
 	}
+	l = len(m.AssetId)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
 	return n
 }
 
@@ -2512,6 +3050,45 @@ func (m *IssueAsset) Size() (n int) {
 	return n
 }
 
+func (m *TransferOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTransaction(uint64(m.Amount))
+	}
+	l = len(m.AssetId)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTransferAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTransaction(x uint64) (n int) {
 	for {
 		n++
@@ -2534,6 +3111,7 @@ func (this *UnsignedTx) String() string {
 		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
 		`Fee:` + fmt.Sprintf("%v", this.Fee) + `,`,
 		`Attributes:` + fmt.Sprintf("%v", this.Attributes) + `,`,
+		`ValidUntilHeight:` + fmt.Sprintf("%v", this.ValidUntilHeight) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2605,6 +3183,18 @@ func (this *RegisterName) String() string {
 	}, "")
 	return s
 }
+func (this *TransferName) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransferName{`,
+		`Registrant:` + fmt.Sprintf("%v", this.Registrant) + `,`,
+		`Recipient:` + fmt.Sprintf("%v", this.Recipient) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DeleteName) String() string {
 	if this == nil {
 		return "nil"
@@ -2639,6 +3229,7 @@ func (this *TransferAsset) String() string {
 		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
 		`Recipient:` + fmt.Sprintf("%v", this.Recipient) + `,`,
 		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`AssetId:` + fmt.Sprintf("%v", this.AssetId) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2683,6 +3274,29 @@ func (this *IssueAsset) String() string {
 	}, "")
 	return s
 }
+func (this *TransferOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransferOutput{`,
+		`Recipient:` + fmt.Sprintf("%v", this.Recipient) + `,`,
+		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`AssetId:` + fmt.Sprintf("%v", this.AssetId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BatchTransferAsset) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BatchTransferAsset{`,
+		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
+		`Outputs:` + strings.Replace(fmt.Sprintf("%v", this.Outputs), "TransferOutput", "TransferOutput", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringTransaction(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2822,11 +3436,30 @@ func (m *UnsignedTx) Unmarshal(dAtA []byte) error {
 				m.Attributes = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTransaction(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntilHeight", wireType)
+			}
+			m.ValidUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntilHeight |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if skippy < 0 {
 				return ErrInvalidLengthTransaction
@@ -3522,6 +4155,147 @@ func (m *RegisterName) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TransferName) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferName: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferName: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registrant", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registrant = append(m.Registrant[:0], dAtA[iNdEx:postIndex]...)
+			if m.Registrant == nil {
+				m.Registrant = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DeleteName) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3948,6 +4722,37 @@ func (m *TransferAsset) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetId = append(m.AssetId[:0], dAtA[iNdEx:postIndex]...)
+			if m.AssetId == nil {
+				m.AssetId = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])
@@ -4434,6 +5239,249 @@ func (m *IssueAsset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TransferOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetId = append(m.AssetId[:0], dAtA[iNdEx:postIndex]...)
+			if m.AssetId == nil {
+				m.AssetId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTransferAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTransferAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTransferAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, &TransferOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTransaction(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4539,64 +5587,71 @@ var (
 	ErrIntOverflowTransaction   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("pb/transaction.proto", fileDescriptor_transaction_faefe0481866d6cb) }
-
-var fileDescriptor_transaction_faefe0481866d6cb = []byte{
-	// 888 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0x33, 0x49, 0x36, 0xd9, 0xbc, 0x64, 0xd3, 0xed, 0x74, 0x55, 0xa2, 0x0a, 0xac, 0x60,
-	0x54, 0xb1, 0x20, 0x91, 0x95, 0xca, 0x11, 0x0e, 0x24, 0x59, 0x37, 0x44, 0x80, 0xbb, 0x1a, 0x7b,
-	0x51, 0x7b, 0x40, 0xd6, 0x38, 0x99, 0x4d, 0x47, 0x4d, 0xc6, 0x96, 0x3d, 0x96, 0x62, 0x71, 0xe9,
-	0x47, 0x80, 0x6f, 0x01, 0xdf, 0x80, 0x13, 0x67, 0x8e, 0x7b, 0xec, 0x91, 0xcd, 0x5e, 0x38, 0xf6,
-	0xc8, 0x11, 0xcd, 0x78, 0x92, 0x18, 0x84, 0x38, 0xac, 0xb8, 0xcd, 0xff, 0xf7, 0xde, 0x3c, 0xff,
-	0xdf, 0xf3, 0x8b, 0x03, 0x27, 0x71, 0x78, 0x26, 0x13, 0x2a, 0x52, 0x3a, 0x93, 0x3c, 0x12, 0x83,
-	0x38, 0x89, 0x64, 0x84, 0xab, 0x71, 0xf8, 0xe8, 0x93, 0x05, 0x97, 0x2f, 0xb3, 0x70, 0x30, 0x8b,
-	0x56, 0x67, 0x8b, 0x68, 0x11, 0x9d, 0xe9, 0x50, 0x98, 0x5d, 0x69, 0xa5, 0x85, 0x3e, 0x15, 0x57,
-	0xec, 0xef, 0x01, 0x2e, 0x45, 0xca, 0x17, 0x82, 0xcd, 0xfd, 0x35, 0x7e, 0x0c, 0xcd, 0x98, 0xe6,
-	0xcb, 0x88, 0xce, 0x7b, 0xa8, 0x8f, 0x4e, 0xdb, 0x4f, 0xda, 0x83, 0x38, 0x1c, 0x5c, 0x14, 0x88,
-	0x6c, 0x63, 0xf8, 0x04, 0x0e, 0x44, 0x24, 0x66, 0xac, 0x57, 0xed, 0xa3, 0xd3, 0x3a, 0x29, 0x04,
-	0x3e, 0x86, 0xda, 0x15, 0x63, 0xbd, 0x5a, 0x1f, 0x9d, 0xd6, 0x88, 0x3a, 0x62, 0x0b, 0x80, 0x4a,
-	0x99, 0xf0, 0x30, 0x93, 0x2c, 0xed, 0xd5, 0xfb, 0xe8, 0xb4, 0x43, 0x4a, 0xc4, 0x5e, 0x40, 0xdb,
-	0xdf, 0x37, 0x81, 0xcf, 0xa0, 0x9d, 0x19, 0x2f, 0x81, 0x5c, 0x1b, 0x07, 0x5d, 0xe5, 0x60, 0x6f,
-	0x91, 0x40, 0xb6, 0xb7, 0xfb, 0x21, 0x1c, 0xc6, 0x49, 0xb4, 0x48, 0xe8, 0x2a, 0xed, 0x55, 0xfb,
-	0xb5, 0x9d, 0xdf, 0x82, 0x91, 0x5d, 0xd0, 0xfe, 0x0c, 0x9a, 0x06, 0x62, 0x0c, 0xf5, 0x59, 0x34,
-	0x67, 0xba, 0x7a, 0x87, 0xe8, 0x33, 0x7e, 0x17, 0x5a, 0x31, 0x4d, 0xe8, 0x8a, 0x49, 0x96, 0xe8,
-	0x9e, 0x3a, 0x64, 0x0f, 0xec, 0x11, 0x34, 0xcd, 0x04, 0xf0, 0x07, 0x50, 0x97, 0x79, 0x5c, 0x5c,
-	0xee, 0x3e, 0xb9, 0x57, 0x1a, 0x8e, 0x9f, 0xc7, 0x8c, 0xe8, 0xa0, 0x7a, 0xc2, 0x9c, 0x4a, 0x6a,
-	0x0a, 0xe9, 0xb3, 0xfd, 0x1c, 0x0e, 0xc7, 0x11, 0x17, 0x21, 0x4d, 0x19, 0x7e, 0x08, 0x8d, 0x94,
-	0x89, 0x39, 0x4b, 0x8c, 0x07, 0xa3, 0x94, 0x8b, 0x84, 0xcd, 0x78, 0xcc, 0x99, 0x90, 0x5b, 0x17,
-	0x3b, 0xa0, 0x6e, 0xd1, 0x55, 0x94, 0x09, 0x69, 0x06, 0x6c, 0x94, 0x3d, 0x81, 0xb6, 0xc7, 0x17,
-	0xe3, 0x97, 0x94, 0x0b, 0x7f, 0x2d, 0xf0, 0x23, 0x38, 0x4c, 0x8d, 0x34, 0xe5, 0x77, 0x5a, 0x3d,
-	0x20, 0xcd, 0xc2, 0x15, 0x97, 0xa5, 0x36, 0x77, 0xc0, 0x1e, 0x41, 0x87, 0xb0, 0x05, 0x4f, 0x25,
-	0x4b, 0x5c, 0xba, 0xd2, 0x2f, 0x2f, 0xd1, 0x3a, 0xa1, 0x42, 0x9a, 0x5a, 0x25, 0xa2, 0xda, 0x14,
-	0x74, 0x55, 0xec, 0x40, 0x8b, 0xe8, 0xb3, 0xfd, 0x05, 0xc0, 0x39, 0x5b, 0x32, 0xc9, 0xee, 0x5c,
-	0xe1, 0x67, 0x04, 0x2d, 0x2f, 0x0b, 0xd3, 0x59, 0xc2, 0x43, 0x5d, 0x21, 0xdd, 0x8a, 0xed, 0xb8,
-	0x4a, 0x44, 0xc5, 0xf9, 0x9c, 0x09, 0xc9, 0xaf, 0xb8, 0x69, 0xa9, 0x45, 0x4a, 0x44, 0x2d, 0xaa,
-	0x8c, 0x62, 0x3e, 0xd3, 0x33, 0x6b, 0x91, 0x42, 0xa8, 0x51, 0x86, 0xd9, 0xec, 0x15, 0x93, 0x7a,
-	0x25, 0x8f, 0x88, 0x51, 0x6a, 0x76, 0xf3, 0x2c, 0xa1, 0x6a, 0x17, 0x7b, 0x07, 0x3a, 0xb2, 0xd3,
-	0xca, 0xeb, 0x8a, 0x49, 0xda, 0x6b, 0x14, 0x5e, 0xd5, 0xd9, 0xfe, 0x0e, 0x8e, 0xf4, 0xfa, 0x5e,
-	0xb1, 0x64, 0x98, 0xa6, 0x4c, 0xfe, 0xcf, 0x6f, 0xf6, 0x5b, 0x80, 0x09, 0x13, 0x2c, 0xa1, 0x92,
-	0x4d, 0xcf, 0xf1, 0x7b, 0x00, 0x71, 0x16, 0x2e, 0xf9, 0x2c, 0x78, 0xc5, 0x72, 0x53, 0xbf, 0x55,
-	0x90, 0xaf, 0x58, 0x8e, 0x3f, 0x82, 0xe3, 0xed, 0x64, 0x95, 0xdf, 0x40, 0xfd, 0x12, 0xab, 0xba,
-	0xdc, 0xbd, 0x32, 0x7f, 0xca, 0x98, 0xfd, 0x2b, 0x82, 0xa6, 0x4b, 0x45, 0x74, 0x41, 0xf3, 0x3b,
-	0x3a, 0xee, 0x42, 0x95, 0xcf, 0xb5, 0xdb, 0x3a, 0xa9, 0xf2, 0x79, 0xa9, 0x83, 0x7a, 0xb9, 0x03,
-	0xfc, 0x18, 0xba, 0x72, 0x2d, 0x02, 0xb6, 0x8e, 0xf9, 0xdf, 0xc6, 0x7a, 0x24, 0xd7, 0xc2, 0xd9,
-	0x41, 0x3c, 0x80, 0x07, 0x82, 0x8a, 0x28, 0x88, 0x69, 0x5e, 0xce, 0x6d, 0xe8, 0xdc, 0xfb, 0xa2,
-	0xb0, 0xba, 0xcf, 0xb7, 0x7f, 0x44, 0x00, 0xd3, 0x34, 0xcd, 0xd8, 0x7f, 0x4f, 0xfd, 0x5f, 0xd6,
-	0x4b, 0xe7, 0xe6, 0xab, 0x30, 0x5a, 0x9a, 0x8d, 0x30, 0x0a, 0xbf, 0x0f, 0x1d, 0x19, 0x49, 0xba,
-	0x0c, 0xd2, 0x2c, 0x8e, 0x97, 0xb9, 0xe9, 0xa3, 0xad, 0x99, 0xa7, 0x91, 0xfe, 0x48, 0xa8, 0x11,
-	0xa4, 0xfb, 0x3e, 0xf6, 0xe0, 0xe3, 0xd7, 0x55, 0x68, 0x97, 0x3e, 0x05, 0xf8, 0x3e, 0x1c, 0x8d,
-	0x9f, 0x4d, 0xdd, 0xd1, 0xd0, 0x73, 0x02, 0xff, 0xc5, 0x85, 0x73, 0x5c, 0xc1, 0xef, 0xc0, 0x03,
-	0x9f, 0x0c, 0x5d, 0xef, 0xa9, 0x43, 0x82, 0xa1, 0xe7, 0x39, 0x7e, 0x11, 0x40, 0xf8, 0x21, 0x60,
-	0x6f, 0x3a, 0x09, 0xc6, 0x5f, 0x0e, 0xa7, 0x6e, 0xe0, 0x3f, 0x77, 0x0b, 0x5e, 0x55, 0x9c, 0x38,
-	0x93, 0xa9, 0xe7, 0x3b, 0x24, 0x70, 0x87, 0xdf, 0x98, 0x42, 0x35, 0xc5, 0x77, 0x85, 0xf6, 0xbc,
-	0x8e, 0x4f, 0xe0, 0xf8, 0xdc, 0xf9, 0xda, 0xf1, 0x9d, 0x12, 0x3d, 0xc0, 0x18, 0xba, 0xde, 0xe5,
-	0xc8, 0x1b, 0x93, 0xe9, 0xc8, 0xb0, 0x86, 0xca, 0xbc, 0x74, 0xff, 0x41, 0x9b, 0x8a, 0x4e, 0x1c,
-	0xd7, 0x21, 0x43, 0xdf, 0x09, 0xa6, 0xe7, 0x05, 0x3d, 0x54, 0x9d, 0xb8, 0x43, 0xf7, 0x59, 0x70,
-	0x31, 0x7c, 0x51, 0xa0, 0x96, 0x4a, 0x9c, 0x7a, 0xde, 0xa5, 0x53, 0x6e, 0x03, 0x46, 0x9f, 0x5f,
-	0xdf, 0x58, 0x95, 0x37, 0x37, 0x56, 0xe5, 0xed, 0x8d, 0x85, 0xfe, 0xbc, 0xb1, 0xd0, 0xeb, 0x8d,
-	0x85, 0x7e, 0xda, 0x58, 0xe8, 0x97, 0x8d, 0x85, 0x7e, 0xdb, 0x58, 0xe8, 0x7a, 0x63, 0xa1, 0xdf,
-	0x37, 0x16, 0xfa, 0x63, 0x63, 0x55, 0xde, 0x6e, 0x2c, 0xf4, 0xc3, 0xad, 0x55, 0xb9, 0xbe, 0xb5,
-	0x2a, 0x6f, 0x6e, 0xad, 0x4a, 0xd8, 0xd0, 0xff, 0x47, 0x9f, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff,
-	0xf9, 0xe6, 0x7b, 0x15, 0xda, 0x06, 0x00, 0x00,
+func init() { proto.RegisterFile("pb/transaction.proto", fileDescriptor_transaction_670618a1d69313c0) }
+
+var fileDescriptor_transaction_670618a1d69313c0 = []byte{
+	// 1007 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x6f, 0xdb, 0x36,
+	0x14, 0xc7, 0x4d, 0xdb, 0x8d, 0xe3, 0x67, 0xc7, 0x4d, 0xd9, 0xa0, 0x73, 0x8b, 0x55, 0xf0, 0x34,
+	0x14, 0xcb, 0x86, 0x2e, 0x01, 0xba, 0xe3, 0x76, 0x98, 0xed, 0xa8, 0x89, 0xb1, 0xcd, 0x09, 0x28,
+	0x65, 0x68, 0x77, 0xd1, 0x28, 0x8b, 0x71, 0x88, 0xda, 0x94, 0x20, 0x51, 0x83, 0x7d, 0xdb, 0x47,
+	0xd8, 0x3e, 0xc1, 0xae, 0xeb, 0x37, 0xd8, 0x69, 0xe7, 0x1d, 0x73, 0xec, 0x71, 0x71, 0x2e, 0x3b,
+	0xf6, 0xb8, 0xe3, 0x40, 0x8a, 0xb6, 0x95, 0xa2, 0x2d, 0x8a, 0x62, 0x37, 0xbe, 0xdf, 0xa3, 0x1e,
+	0xdf, 0xff, 0xbd, 0x47, 0x42, 0xb0, 0x13, 0x07, 0xfb, 0x32, 0xa1, 0x22, 0xa5, 0x23, 0xc9, 0x23,
+	0xb1, 0x17, 0x27, 0x91, 0x8c, 0x70, 0x39, 0x0e, 0xee, 0x7d, 0x3e, 0xe6, 0xf2, 0x3c, 0x0b, 0xf6,
+	0x46, 0xd1, 0x74, 0x7f, 0x1c, 0x8d, 0xa3, 0x7d, 0xed, 0x0a, 0xb2, 0x33, 0x6d, 0x69, 0x43, 0xaf,
+	0xf2, 0x4f, 0xec, 0xe7, 0x08, 0xe0, 0x54, 0xa4, 0x7c, 0x2c, 0x58, 0xe8, 0xcd, 0xf0, 0x03, 0xa8,
+	0xc5, 0x74, 0x3e, 0x89, 0x68, 0xd8, 0x46, 0x1d, 0xb4, 0xdb, 0x78, 0xd4, 0xd8, 0x8b, 0x83, 0xbd,
+	0x93, 0x1c, 0x91, 0xa5, 0x0f, 0xef, 0xc0, 0x0d, 0x11, 0x89, 0x11, 0x6b, 0x97, 0x3b, 0x68, 0xb7,
+	0x4a, 0x72, 0x03, 0x6f, 0x43, 0xe5, 0x8c, 0xb1, 0x76, 0xa5, 0x83, 0x76, 0x2b, 0x44, 0x2d, 0xb1,
+	0x05, 0x40, 0xa5, 0x4c, 0x78, 0x90, 0x49, 0x96, 0xb6, 0xab, 0x1d, 0xb4, 0xdb, 0x24, 0x05, 0x82,
+	0x1f, 0x02, 0xfe, 0x89, 0x4e, 0x78, 0xe8, 0x67, 0x42, 0xf2, 0x89, 0x7f, 0xce, 0xf8, 0xf8, 0x5c,
+	0xb6, 0x6f, 0x74, 0xd0, 0xee, 0x16, 0xd9, 0xd6, 0x9e, 0x53, 0xe5, 0x38, 0xd2, 0xdc, 0x1e, 0x43,
+	0xc3, 0x5b, 0x6b, 0xc6, 0xfb, 0xd0, 0xc8, 0x4c, 0xe6, 0xbe, 0x9c, 0x99, 0x7c, 0x5b, 0x2a, 0xdf,
+	0xb5, 0x20, 0x02, 0xd9, 0x5a, 0xdc, 0x27, 0xb0, 0x19, 0x27, 0xd1, 0x38, 0xa1, 0xd3, 0xb4, 0x5d,
+	0xee, 0x54, 0x56, 0xea, 0x72, 0x46, 0x56, 0x4e, 0xfb, 0x4b, 0xa8, 0x19, 0x88, 0x31, 0x54, 0x47,
+	0x51, 0xc8, 0x74, 0xf4, 0x26, 0xd1, 0x6b, 0xfc, 0x21, 0xd4, 0x63, 0x9a, 0xd0, 0x29, 0x93, 0x2c,
+	0xd1, 0x15, 0x68, 0x92, 0x35, 0xb0, 0x7b, 0x50, 0x33, 0xf5, 0xc2, 0x1f, 0x43, 0x55, 0xce, 0xe3,
+	0xfc, 0xe3, 0xd6, 0xa3, 0x9b, 0x85, 0x52, 0x7a, 0xf3, 0x98, 0x11, 0xed, 0x54, 0x27, 0x84, 0x54,
+	0x52, 0x13, 0x48, 0xaf, 0xed, 0x27, 0xb0, 0xd9, 0x8f, 0xb8, 0x08, 0x68, 0xca, 0xf0, 0x1d, 0xd8,
+	0x48, 0x99, 0x08, 0x59, 0x62, 0x72, 0x30, 0x96, 0xca, 0x22, 0x61, 0x23, 0x1e, 0x73, 0x26, 0xe4,
+	0x32, 0x8b, 0x15, 0x50, 0x5f, 0xd1, 0x69, 0x94, 0x09, 0x69, 0xda, 0x61, 0x2c, 0xfb, 0x10, 0x1a,
+	0x2e, 0x1f, 0xf7, 0xcf, 0x29, 0x17, 0xde, 0x4c, 0xe0, 0x7b, 0xb0, 0x99, 0x1a, 0xd3, 0x84, 0x5f,
+	0xd9, 0xea, 0x80, 0x34, 0x0b, 0xa6, 0x5c, 0x16, 0x64, 0xae, 0x80, 0xdd, 0x83, 0x26, 0x61, 0x63,
+	0x9e, 0x4a, 0x96, 0x0c, 0xe9, 0x54, 0xb7, 0x3a, 0xd1, 0x76, 0x42, 0x85, 0x34, 0xb1, 0x0a, 0x44,
+	0xc9, 0x14, 0x74, 0x9a, 0x4f, 0x4c, 0x9d, 0xe8, 0xb5, 0xfd, 0x23, 0x34, 0x75, 0x43, 0xcf, 0xde,
+	0x31, 0xc6, 0xdb, 0x25, 0x2f, 0x4f, 0xa8, 0x14, 0x4e, 0xf8, 0x1a, 0xe0, 0x80, 0x4d, 0x98, 0x64,
+	0xef, 0x9d, 0xe3, 0x73, 0x04, 0x75, 0x37, 0x0b, 0xd2, 0x51, 0xc2, 0x03, 0x1d, 0x21, 0x5d, 0x1a,
+	0xcb, 0x86, 0x14, 0x88, 0xf2, 0xf3, 0x90, 0x09, 0xc9, 0xcf, 0xb8, 0x29, 0x5a, 0x9d, 0x14, 0x88,
+	0xba, 0x38, 0x32, 0x8a, 0xf9, 0xc8, 0x24, 0x99, 0x1b, 0xaa, 0x59, 0x41, 0x36, 0x7a, 0xc6, 0xa4,
+	0xbe, 0x22, 0x5b, 0xc4, 0x58, 0xaa, 0x3b, 0x61, 0x96, 0x50, 0x35, 0xed, 0xe6, 0x52, 0xac, 0x6c,
+	0x95, 0xeb, 0x94, 0x49, 0xda, 0xde, 0xc8, 0x73, 0x55, 0x6b, 0x7b, 0x06, 0x5b, 0xcb, 0x7a, 0x76,
+	0xd3, 0x94, 0xc9, 0xff, 0x77, 0x76, 0xf0, 0x5d, 0xd8, 0xa4, 0x2a, 0xac, 0xcf, 0x43, 0x73, 0x97,
+	0x6b, 0xda, 0x1e, 0x84, 0xf6, 0xf7, 0x00, 0x87, 0x4c, 0xb0, 0x84, 0x4a, 0x36, 0x38, 0xc0, 0xf7,
+	0x01, 0xe2, 0x2c, 0x98, 0xf0, 0x91, 0xff, 0x8c, 0xcd, 0xcd, 0xd1, 0xf5, 0x9c, 0x7c, 0xc3, 0xe6,
+	0xf8, 0x53, 0xd8, 0x5e, 0x16, 0x5d, 0x49, 0xf1, 0xd5, 0xa3, 0x51, 0xd6, 0x27, 0xdd, 0x2c, 0xf2,
+	0xc7, 0x8c, 0xd9, 0x7f, 0x22, 0xa8, 0x0d, 0xa9, 0x88, 0x4e, 0xe8, 0xfc, 0x3d, 0xc5, 0xb4, 0xa0,
+	0xcc, 0x43, 0x2d, 0xa4, 0x4a, 0xca, 0x3c, 0x2c, 0x88, 0xab, 0x5e, 0x13, 0xf7, 0x00, 0x5a, 0x72,
+	0x26, 0x7c, 0x36, 0x8b, 0xf9, 0xb5, 0x8a, 0x6f, 0xc9, 0x99, 0x70, 0x56, 0x10, 0xef, 0xc1, 0x6d,
+	0x41, 0x45, 0xe4, 0xc7, 0x74, 0x5e, 0xdc, 0xbb, 0xa1, 0xf7, 0xde, 0x12, 0x79, 0xaa, 0xeb, 0xfd,
+	0xf6, 0xaf, 0x08, 0x60, 0x90, 0xa6, 0x19, 0x7b, 0x7b, 0x43, 0x5e, 0x33, 0x79, 0x7a, 0xef, 0x7c,
+	0x1a, 0x44, 0x13, 0x33, 0x2c, 0xc6, 0xc2, 0x1f, 0x41, 0x53, 0x46, 0x92, 0x4e, 0xfc, 0x34, 0x8b,
+	0xe3, 0xc9, 0xdc, 0xe8, 0x68, 0x68, 0xe6, 0x6a, 0xa4, 0x5f, 0x28, 0x55, 0x82, 0x74, 0xad, 0x63,
+	0x0d, 0x6c, 0x0a, 0xad, 0xe5, 0x98, 0x1c, 0x67, 0x32, 0xce, 0x5e, 0xb9, 0x58, 0xe8, 0xcd, 0xf3,
+	0x50, 0x7e, 0xe3, 0x3c, 0x54, 0xae, 0xcf, 0xc3, 0x0f, 0x80, 0x7b, 0x54, 0x8e, 0xce, 0xdf, 0x6d,
+	0x1c, 0x1f, 0x42, 0x2d, 0xd2, 0x89, 0x2c, 0xdf, 0x65, 0xac, 0x9e, 0xca, 0xeb, 0x39, 0x92, 0xe5,
+	0x96, 0xcf, 0x7e, 0x2b, 0x43, 0xa3, 0xf0, 0x8c, 0xe2, 0x5b, 0xb0, 0xd5, 0x3f, 0x1e, 0x0c, 0x7b,
+	0x5d, 0xd7, 0xf1, 0xbd, 0xa7, 0x27, 0xce, 0x76, 0x09, 0x7f, 0x00, 0xb7, 0x3d, 0xd2, 0x1d, 0xba,
+	0x8f, 0x1d, 0xe2, 0x77, 0x5d, 0xd7, 0xf1, 0x72, 0x07, 0xc2, 0x77, 0x00, 0xbb, 0x83, 0x43, 0xbf,
+	0x7f, 0xd4, 0x1d, 0x0c, 0x7d, 0xef, 0xc9, 0x30, 0xe7, 0x65, 0xc5, 0x89, 0x73, 0x38, 0x70, 0x3d,
+	0x87, 0xf8, 0xc3, 0xee, 0x77, 0x26, 0x50, 0x45, 0xf1, 0x55, 0xa0, 0x35, 0xaf, 0xe2, 0x1d, 0xd8,
+	0x3e, 0x70, 0xbe, 0x75, 0x3c, 0xa7, 0x40, 0x6f, 0x60, 0x0c, 0x2d, 0xf7, 0xb4, 0xe7, 0xf6, 0xc9,
+	0xa0, 0x67, 0xd8, 0x86, 0xda, 0x79, 0x3a, 0x7c, 0x85, 0xd6, 0x14, 0x3d, 0x74, 0x86, 0x0e, 0xe9,
+	0x7a, 0x8e, 0x3f, 0x38, 0xc8, 0xe9, 0xa6, 0x52, 0x32, 0xec, 0x0e, 0x8f, 0xfd, 0x93, 0xee, 0xd3,
+	0x1c, 0xd5, 0xd5, 0xc6, 0x81, 0xeb, 0x9e, 0x3a, 0x45, 0x19, 0x80, 0xef, 0xc3, 0xdd, 0x5e, 0xd7,
+	0xeb, 0x1f, 0xf9, 0xaf, 0x53, 0xd9, 0xe8, 0x7d, 0x75, 0x71, 0x69, 0x95, 0x5e, 0x5c, 0x5a, 0xa5,
+	0x97, 0x97, 0x16, 0xfa, 0xf7, 0xd2, 0x42, 0x3f, 0x2f, 0x2c, 0xf4, 0xfb, 0xc2, 0x42, 0x7f, 0x2c,
+	0x2c, 0xf4, 0xd7, 0xc2, 0x42, 0x17, 0x0b, 0x0b, 0xfd, 0xbd, 0xb0, 0xd0, 0x3f, 0x0b, 0xab, 0xf4,
+	0x72, 0x61, 0xa1, 0x5f, 0xae, 0xac, 0xd2, 0xc5, 0x95, 0x55, 0x7a, 0x71, 0x65, 0x95, 0x82, 0x0d,
+	0xfd, 0x67, 0xf0, 0xc5, 0x7f, 0x03, 0x00, 0xd2, 0x45, 0x26, 0x42, 0x64, 0x08, 0x00, 0x00,
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/nknorg/nkn/common"
 	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/util/config"
 )
 
 const (
@@ -37,6 +38,8 @@ func Unpack(payload *pb.Payload) (IPayload, error) {
 		pl = new(pb.SigChainTxn)
 	case pb.REGISTER_NAME_TYPE:
 		pl = new(pb.RegisterName)
+	case pb.TRANSFER_NAME_TYPE:
+		pl = new(pb.TransferName)
 	case pb.DELETE_NAME_TYPE:
 		pl = new(pb.DeleteName)
 	case pb.SUBSCRIBE_TYPE:
@@ -47,6 +50,8 @@ func Unpack(payload *pb.Payload) (IPayload, error) {
 		pl = new(pb.NanoPay)
 	case pb.ISSUE_ASSET_TYPE:
 		pl = new(pb.IssueAsset)
+	case pb.BATCH_TRANSFER_ASSET_TYPE:
+		pl = new(pb.BatchTransferAsset)
 	default:
 		return nil, errors.New("invalid payload type.")
 	}
@@ -63,12 +68,35 @@ func NewCoinbase(sender, recipient common.Uint160, amount common.Fixed64) IPaylo
 		Amount:    int64(amount),
 	}
 }
-func NewTransferAsset(sender, recipient common.Uint160, amount common.Fixed64) IPayload {
-	return &pb.TransferAsset{
+
+func NewTransferAsset(sender, recipient common.Uint160, assetID common.Uint256, amount common.Fixed64) IPayload {
+	pld := &pb.TransferAsset{
 		Sender:    sender.ToArray(),
 		Recipient: recipient.ToArray(),
 		Amount:    int64(amount),
 	}
+	if assetID != config.NKNAssetID {
+		pld.AssetId = assetID.ToArray()
+	}
+	return pld
+}
+
+func NewTransferOutput(recipient common.Uint160, assetID common.Uint256, amount common.Fixed64) *pb.TransferOutput {
+	output := &pb.TransferOutput{
+		Recipient: recipient.ToArray(),
+		Amount:    int64(amount),
+	}
+	if assetID != config.NKNAssetID {
+		output.AssetId = assetID.ToArray()
+	}
+	return output
+}
+
+func NewBatchTransferAsset(sender common.Uint160, outputs []*pb.TransferOutput) IPayload {
+	return &pb.BatchTransferAsset{
+		Sender:  sender.ToArray(),
+		Outputs: outputs,
+	}
 }
 
 func NewSigChainTxn(sigChain []byte, submitter common.Uint160) IPayload {
@@ -84,6 +112,14 @@ func NewRegisterName(registrant []byte, name string) IPayload {
 	}
 }
 
+func NewTransferName(registrant, recipient []byte, name string) IPayload {
+	return &pb.TransferName{
+		Registrant: registrant,
+		Recipient:  recipient,
+		Name:       name,
+	}
+}
+
 func NewDeleteName(registrant []byte, name string) IPayload {
 	return &pb.DeleteName{
 		Registrant: registrant,
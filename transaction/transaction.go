@@ -2,6 +2,7 @@ package transaction
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"io"
@@ -68,6 +69,14 @@ func (tx *Transaction) SerializeUnsigned(w io.Writer) error {
 		return err
 	}
 
+	// only written when set, so hashes of txns without it are unchanged
+	if tx.UnsignedTx.ValidUntilHeight > 0 {
+		err = serialization.WriteUint32(w, tx.UnsignedTx.ValidUntilHeight)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -94,6 +103,18 @@ func (tx *Transaction) DeserializeUnsigned(r io.Reader) error {
 	}
 	tx.UnsignedTx.Attributes = val
 
+	// absent if the txn has no valid until height, but a partial one is an error
+	var validUntil [4]byte
+	_, err = io.ReadFull(r, validUntil[:])
+	switch err {
+	case nil:
+		tx.UnsignedTx.ValidUntilHeight = binary.LittleEndian.Uint32(validUntil[:])
+	case io.EOF:
+		tx.UnsignedTx.ValidUntilHeight = 0
+	default:
+		return err
+	}
+
 	return nil
 }
 
@@ -120,6 +141,9 @@ func (tx *Transaction) GetProgramHashes() ([]Uint160, error) {
 	case pb.COINBASE_TYPE:
 		sender := payload.(*pb.Coinbase).Sender
 		hashes = append(hashes, BytesToUint160(sender))
+	case pb.BATCH_TRANSFER_ASSET_TYPE:
+		sender := payload.(*pb.BatchTransferAsset).Sender
+		hashes = append(hashes, BytesToUint160(sender))
 	case pb.REGISTER_NAME_TYPE:
 		pubkey := payload.(*pb.RegisterName).Registrant
 		publicKey, err := crypto.NewPubKeyFromBytes(pubkey)
@@ -131,6 +155,17 @@ func (tx *Transaction) GetProgramHashes() ([]Uint160, error) {
 			return nil, err
 		}
 		hashes = append(hashes, programhash)
+	case pb.TRANSFER_NAME_TYPE:
+		pubkey := payload.(*pb.TransferName).Registrant
+		publicKey, err := crypto.NewPubKeyFromBytes(pubkey)
+		if err != nil {
+			return nil, err
+		}
+		programhash, err := program.CreateProgramHash(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, programhash)
 	case pb.DELETE_NAME_TYPE:
 		pubkey := payload.(*pb.DeleteName).Registrant
 		publicKey, err := crypto.NewPubKeyFromBytes(pubkey)
@@ -262,6 +297,7 @@ func (tx *Transaction) GetInfo() ([]byte, error) {
 		Nonce       uint64        `json:"nonce"`
 		Fee         int64         `json:"fee"`
 		Attributes  string        `json:"attributes"`
+		ValidUntil  uint32        `json:"validUntilHeight,omitempty"`
 		Programs    []programInfo `json:"programs"`
 		Hash        string        `json:"hash"`
 	}
@@ -273,6 +309,7 @@ func (tx *Transaction) GetInfo() ([]byte, error) {
 		Nonce:       tx.UnsignedTx.Nonce,
 		Fee:         tx.UnsignedTx.Fee,
 		Attributes:  BytesToHexString(tx.UnsignedTx.Attributes),
+		ValidUntil:  tx.UnsignedTx.ValidUntilHeight,
 		Programs:    make([]programInfo, 0),
 		Hash:        tx.hash.ToHexString(),
 	}
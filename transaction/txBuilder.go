@@ -10,8 +10,8 @@ const (
 	TransactionNonceLength = 32
 )
 
-func NewTransferAssetTransaction(sender, recipient Uint160, nonce uint64, value, fee Fixed64) (*Transaction, error) {
-	payload := NewTransferAsset(sender, recipient, value)
+func NewTransferAssetTransaction(sender, recipient Uint160, assetID Uint256, nonce uint64, value, fee Fixed64) (*Transaction, error) {
+	payload := NewTransferAsset(sender, recipient, assetID, value)
 	pl, err := Pack(pb.TRANSFER_ASSET_TYPE, payload)
 	if err != nil {
 		return nil, err
@@ -24,6 +24,20 @@ func NewTransferAssetTransaction(sender, recipient Uint160, nonce uint64, value,
 	}, nil
 }
 
+func NewBatchTransferAssetTransaction(sender Uint160, outputs []*pb.TransferOutput, nonce uint64, fee Fixed64) (*Transaction, error) {
+	payload := NewBatchTransferAsset(sender, outputs)
+	pl, err := Pack(pb.BATCH_TRANSFER_ASSET_TYPE, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := NewMsgTx(pl, nonce, fee, util.RandomBytes(TransactionNonceLength))
+
+	return &Transaction{
+		Transaction: tx,
+	}, nil
+}
+
 func NewSigChainTransaction(sigChain []byte, submitter Uint160, nonce uint64) (*Transaction, error) {
 	payload := NewSigChainTxn(sigChain, submitter)
 	pl, err := Pack(pb.SIG_CHAIN_TXN_TYPE, payload)
@@ -52,6 +66,20 @@ func NewRegisterNameTransaction(registrant []byte, name string, nonce uint64, fe
 	}, nil
 }
 
+func NewTransferNameTransaction(registrant, recipient []byte, name string, nonce uint64, fee Fixed64) (*Transaction, error) {
+	payload := NewTransferName(registrant, recipient, name)
+	pl, err := Pack(pb.TRANSFER_NAME_TYPE, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := NewMsgTx(pl, nonce, fee, util.RandomBytes(TransactionNonceLength))
+
+	return &Transaction{
+		Transaction: tx,
+	}, nil
+}
+
 func NewDeleteNameTransaction(registrant []byte, name string, nonce uint64, fee Fixed64) (*Transaction, error) {
 	payload := NewDeleteName(registrant, name)
 	pl, err := Pack(pb.DELETE_NAME_TYPE, payload)
@@ -121,3 +149,11 @@ func NewIssueAssetTransaction(sender Uint160, name, symbol string, totalSupply F
 		Transaction: tx,
 	}, nil
 }
+
+// SetValidUntilHeight makes tx invalid in blocks higher than height, 0 means no
+// limit. The height is part of the signed data, so it should be set before tx
+// is signed.
+func (tx *Transaction) SetValidUntilHeight(height uint32) {
+	tx.UnsignedTx.ValidUntilHeight = height
+	tx.hash = nil
+}
@@ -34,7 +34,7 @@ const (
 	MaxRollbackBlocks            = 1
 	SigChainPropogationTime      = 1
 	HeaderVersion                = 1
-	DBVersion                    = 0x01
+	DBVersion                    = 0x02
 	InitialIssueAddress          = "NKNFCrUMFPkSeDRMG2ME21hD6wBCA2poc347"
 	InitialIssueAmount           = 700000000 * common.StorageFactor
 	TotalMiningRewards           = 300000000 * common.StorageFactor
@@ -49,12 +49,26 @@ const (
 	GenerateIDBlockDelay         = 8
 	RandomBeaconUniqueLength     = vrf.Size
 	RandomBeaconLength           = vrf.Size + vrf.ProofSize
-	ProtocolVersion              = 1
+	ProtocolVersion              = 2
 	MinCompatibleProtocolVersion = 1
 	MaxCompatibleProtocolVersion = 9
 	DefaultTxPoolCap             = 32
+	DefaultTxPoolMaxTxns         = 32768
+	DefaultTxPoolMaxSize         = 32 * 1024 * 1024
+	DefaultTxPoolTxnTTL          = 3 * 60 * 60
+	DefaultTxPoolMinFeeBump      = 10
+	DefaultTxPoolFutureCap       = 16
+	DefaultTxPoolMaxFutureTxns   = 4096
+	DefaultTxPoolFutureTTL       = 10 * 60
+	DefaultFeeEstimateBlocks     = 32
 	ShortHashSize                = uint32(8)
 	MaxAssetPrecision            = uint32(8)
+	MaxBatchTransferOutputs      = 1024
+	MinBatchTransferFeePerByte   = common.Fixed64(100)
+	NameRegistrationDuration     = uint32(RewardAdjustInterval)
+	DefaultStatePruningKeepRoots = 128
+	DefaultMinVoteWeight         = 1
+	DefaultMaxVoteWeight         = 10
 	NKNAssetName                 = "NKN"
 	NKNAssetSymbol               = "nkn"
 	NKNAssetPrecision            = uint32(8)
@@ -112,11 +126,25 @@ var (
 		NATPortMappingTimeout:     365 * 86400,
 		NumTxnPerBlock:            256,
 		TxPoolCap:                 DefaultTxPoolCap,
+		TxPoolMaxTxns:             DefaultTxPoolMaxTxns,
+		TxPoolMaxSize:             DefaultTxPoolMaxSize,
+		TxPoolTxnTTL:              DefaultTxPoolTxnTTL,
+		TxPoolMinFeeBump:          DefaultTxPoolMinFeeBump,
+		TxPoolFutureCap:           DefaultTxPoolFutureCap,
+		TxPoolMaxFutureTxns:       DefaultTxPoolMaxFutureTxns,
+		TxPoolFutureTTL:           DefaultTxPoolFutureTTL,
+		FeeEstimateBlocks:         DefaultFeeEstimateBlocks,
+		TxPoolJournal:             "txpool.journal",
 		RegisterIDFee:             0,
 		LogPath:                   "Log",
 		ChainDBPath:               "ChainDB",
+		ChainDBBackend:            "leveldb",
 		WalletFile:                "wallet.json",
 		MaxGetIDSeeds:             3,
+		StatePruningKeepRoots:     DefaultStatePruningKeepRoots,
+		MaxRollbackDepth:          MaxRollbackBlocks,
+		MinVoteWeight:             DefaultMinVoteWeight,
+		MaxVoteWeight:             DefaultMaxVoteWeight,
 	}
 )
 
@@ -151,14 +179,31 @@ type Configuration struct {
 	SyncBlocksBatchSize       uint32        `json:"SyncBlocksBatchSize"`
 	NumTxnPerBlock            uint32        `json:"NumTxnPerBlock"`
 	TxPoolCap                 int           `json:"TxPoolCap"`
+	TxPoolMaxTxns             int           `json:"TxPoolMaxTxns"`
+	TxPoolMaxSize             int           `json:"TxPoolMaxSize"`    // in bytes
+	TxPoolTxnTTL              time.Duration `json:"TxPoolTxnTTL"`     // in seconds
+	TxPoolMinFeeBump          uint32        `json:"TxPoolMinFeeBump"` // in percent
+	TxPoolFutureCap           int           `json:"TxPoolFutureCap"`
+	TxPoolMaxFutureTxns       int           `json:"TxPoolMaxFutureTxns"`
+	TxPoolFutureTTL           time.Duration `json:"TxPoolFutureTTL"` // in seconds
+	FeeEstimateBlocks         uint32        `json:"FeeEstimateBlocks"`
+	TxPoolJournal             string        `json:"TxPoolJournal"`
 	RPCReadTimeout            time.Duration `json:"RPCReadTimeout"`        // in seconds
 	RPCWriteTimeout           time.Duration `json:"RPCWriteTimeout"`       // in seconds
 	KeepAliveTimeout          time.Duration `json:"KeepAliveTimeout"`      // in seconds
+	EnableMetrics             bool          `json:"EnableMetrics"`         // serve /metrics on the JSON-RPC port
 	NATPortMappingTimeout     time.Duration `json:"NATPortMappingTimeout"` // in seconds
 	LogPath                   string        `json:"LogPath"`
 	ChainDBPath               string        `json:"ChainDBPath"`
+	ChainDBBackend            string        `json:"ChainDBBackend"`
 	WalletFile                string        `json:"WalletFile"`
 	MaxGetIDSeeds             uint32        `json:"MaxGetIDSeeds"`
+	TxIndexByAddress          bool          `json:"TxIndexByAddress"`
+	StatePruning              bool          `json:"StatePruning"`
+	StatePruningKeepRoots     uint32        `json:"StatePruningKeepRoots"`
+	MaxRollbackDepth          uint32        `json:"MaxRollbackDepth"`
+	MinVoteWeight             uint32        `json:"MinVoteWeight"` // vote weight of a neighbor with the lowest reputation
+	MaxVoteWeight             uint32        `json:"MaxVoteWeight"` // vote weight of a neighbor with the highest reputation
 }
 
 func Init() error {
@@ -333,6 +378,10 @@ func (config *Configuration) verify() error {
 		return fmt.Errorf("MaxLogFileSize should be >= 1 (MB)")
 	}
 
+	if config.MaxVoteWeight == 0 || config.MinVoteWeight > config.MaxVoteWeight {
+		return fmt.Errorf("MaxVoteWeight should be >= 1 and >= MinVoteWeight")
+	}
+
 	return nil
 }
 
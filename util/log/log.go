@@ -192,6 +192,11 @@ func (l *Logger) Errorf(format string, a ...interface{}) {
 }
 
 func Debug(a ...interface{}) {
+	// debug logs are dropped until Init is called
+	if Log == nil {
+		return
+	}
+
 	Log.RLock()
 	defer Log.RUnlock()
 
@@ -215,6 +220,11 @@ func Debug(a ...interface{}) {
 }
 
 func Debugf(format string, a ...interface{}) {
+	// debug logs are dropped until Init is called
+	if Log == nil {
+		return
+	}
+
 	Log.RLock()
 	defer Log.RUnlock()
 
@@ -2,18 +2,63 @@ package timer
 
 import "time"
 
+// Timer is a timer created by a Clock
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// Clock is a source of time and timers, so that code waiting on time can run
+// on a virtual clock in tests
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+	NewTimer(d time.Duration) Timer
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// SystemClock is the clock of package time
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+type systemTimer struct {
+	*time.Timer
+}
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+func (systemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{time.NewTimer(d)}
+}
+
+func (systemClock) AfterFunc(d time.Duration, f func()) Timer {
+	return systemTimer{time.AfterFunc(d, f)}
+}
+
 // StopTimer stops a timer and clear out the channel if not yet
-func StopTimer(timer *time.Timer) {
+func StopTimer(timer Timer) {
 	if !timer.Stop() {
 		select {
-		case <-timer.C:
+		case <-timer.C():
 		default:
 		}
 	}
 }
 
 // ResetTimer stops and resets a timer
-func ResetTimer(timer *time.Timer, duration time.Duration) {
+func ResetTimer(timer Timer, duration time.Duration) {
 	StopTimer(timer)
 	timer.Reset(duration)
 }